@@ -0,0 +1,52 @@
+package scanner
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/MineTakaki/go-utils/errors"
+	"github.com/MineTakaki/go-utils/types/decimal"
+)
+
+var decimalType = reflect.TypeOf(decimal.Decimal{})
+var nullDecimalType = reflect.TypeOf(decimal.NullDecimal{})
+
+// LenientDecimal Decimal・NullDecimal型のフィールドを寛容な解析器（"1,234", "△500", "12%" 等）で読み取るファクトリーを取得します
+//
+//	その他の型はnextに委譲します（nilの場合は既定のフィールドスキャン関数を使用します）。
+//	空の値はDecimal型の場合は0、NullDecimal型の場合はNULLになります
+func LenientDecimal(p decimal.LenientParser, next ScanFuncFactory) ScanFuncFactory {
+	return func(typ reflect.Type, tag string, options []string) (ScanFunc, error) {
+		switch typ {
+		case decimalType:
+			return func(v reflect.Value, s string) error {
+				d := decimal.Zero
+				if s = strings.TrimSpace(s); s != "" {
+					var err error
+					if d, err = p.Parse(s); err != nil {
+						return errors.Wrapf(ErrScanData, "%v", err)
+					}
+				}
+				v.Set(reflect.ValueOf(d))
+				return nil
+			}, nil
+		case nullDecimalType:
+			return func(v reflect.Value, s string) error {
+				d := decimal.Null
+				if s = strings.TrimSpace(s); s != "" {
+					x, err := p.Parse(s)
+					if err != nil {
+						return errors.Wrapf(ErrScanData, "%v", err)
+					}
+					d = x.Nullable()
+				}
+				v.Set(reflect.ValueOf(d))
+				return nil
+			}, nil
+		}
+		if next != nil {
+			return next(typ, tag, options)
+		}
+		return nil, nil
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/MineTakaki/go-utils/text/scanner"
 	"github.com/MineTakaki/go-utils/types"
+	"github.com/MineTakaki/go-utils/types/decimal"
 )
 
 func TestWithHeadder(t *testing.T) {
@@ -128,3 +129,40 @@ func TestWithHeadder2(t *testing.T) {
 		fmt.Printf("%+v\n", rec)
 	}
 }
+
+func TestWithHeaderLenientDecimal(t *testing.T) {
+	type testT1 struct {
+		Name   string              `header:"name"`
+		Amount decimal.Decimal     `header:"amount"`
+		Rate   decimal.NullDecimal `header:"rate"`
+		Diff   decimal.NullDecimal `header:"diff"`
+	}
+
+	headers := []string{"name", "amount", "rate", "diff"}
+	rec := testT1{}
+	fact := scanner.LenientDecimal(decimal.LenientParser{Percent: true, Kanji: true}, nil)
+	scan, err := scanner.WithHeader(&rec, "header", headers, fact)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	if err = scan.Scan(&rec, []string{"A", "¥1.2万", "８％", ""}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if rec.Name != "A" || rec.Amount.String() != "12000" || rec.Rate.String() != "0.08" || rec.Diff.Valid {
+		t.Errorf("unexpected record: %+v", rec)
+	}
+
+	if err = scan.Scan(&rec, []string{"B", "abc", "", ""}); !errors.Is(err, scanner.ErrScanData) {
+		t.Errorf("expect ErrScanData, actual %v", err)
+	}
+
+	// 既定のフィールドスキャン関数は寛容に読み取りません
+	scan, err = scanner.WithHeader(&rec, "header", headers, nil)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err = scan.Scan(&rec, []string{"C", "1,234", "", "△500"}); err == nil {
+		t.Errorf("error expected: %+v", rec)
+	}
+}
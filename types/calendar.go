@@ -0,0 +1,306 @@
+package types
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	// Calendar 休日カレンダー
+	//
+	//	日本の休日（国民の祝日・振替休日・国民の休日）に加えて、
+	//	会社独自の休業日（年末年始等）と週休日を管理します
+	Calendar struct {
+		mu       sync.RWMutex
+		national bool
+		weekend  [7]bool
+		closures map[Ymd]string
+		annual   []annualClosure
+	}
+
+	annualClosure struct {
+		from, to Md
+		name     string
+	}
+)
+
+// 営業日を探す最大日数（すべての日が休日として設定された場合の無限ループ防止）
+const maxBusinessDaySearch = 3660
+
+var _defaultCalendarMu sync.RWMutex
+var _defaultCalendar = NewCalendar()
+
+// NewCalendar 日本の休日と土日を休日とするカレンダーを生成します
+func NewCalendar() *Calendar {
+	c := &Calendar{national: true, closures: map[Ymd]string{}}
+	c.weekend[time.Saturday] = true
+	c.weekend[time.Sunday] = true
+	return c
+}
+
+// DefaultCalendar Ymd.IsBusinessDay 等で使用するカレンダーを取得します
+func DefaultCalendar() *Calendar {
+	_defaultCalendarMu.RLock()
+	defer _defaultCalendarMu.RUnlock()
+	return _defaultCalendar
+}
+
+// SetDefaultCalendar Ymd.IsBusinessDay 等で使用するカレンダーを設定します
+func SetDefaultCalendar(c *Calendar) {
+	if c == nil {
+		c = NewCalendar()
+	}
+	_defaultCalendarMu.Lock()
+	_defaultCalendar = c
+	_defaultCalendarMu.Unlock()
+}
+
+// SetNational 日本の休日を休日として扱うか設定します
+func (c *Calendar) SetNational(b bool) *Calendar {
+	c.mu.Lock()
+	c.national = b
+	c.mu.Unlock()
+	return c
+}
+
+// SetWeekend 週休日の曜日を設定します
+func (c *Calendar) SetWeekend(days ...time.Weekday) *Calendar {
+	c.mu.Lock()
+	c.weekend = [7]bool{}
+	for _, wd := range days {
+		if wd >= time.Sunday && wd <= time.Saturday {
+			c.weekend[wd] = true
+		}
+	}
+	c.mu.Unlock()
+	return c
+}
+
+// AddClosure 休業日を追加します
+func (c *Calendar) AddClosure(name string, days ...Ymd) *Calendar {
+	c.mu.Lock()
+	for _, ymd := range days {
+		if ymd != 0 {
+			c.closures[ymd] = name
+		}
+	}
+	c.mu.Unlock()
+	return c
+}
+
+// AddClosureRange 期間を指定して休業日を追加します
+func (c *Calendar) AddClosureRange(name string, from, to Ymd) *Calendar {
+	c.mu.Lock()
+	if from != 0 && to != 0 {
+		for ymd := from; ymd <= to; ymd = ymd.Next() {
+			c.closures[ymd] = name
+		}
+	}
+	c.mu.Unlock()
+	return c
+}
+
+// AddAnnualClosure 毎年の休業期間を月日で追加します。from > to の場合は年を跨ぐ期間として扱います
+//
+//	cal.AddAnnualClosure("年末年始", 1229, 103)
+func (c *Calendar) AddAnnualClosure(name string, from, to Md) *Calendar {
+	c.mu.Lock()
+	c.annual = append(c.annual, annualClosure{from: from, to: to, name: name})
+	c.mu.Unlock()
+	return c
+}
+
+// RemoveClosure 休業日を削除します（毎年の休業期間は対象外です）
+func (c *Calendar) RemoveClosure(days ...Ymd) *Calendar {
+	c.mu.Lock()
+	for _, ymd := range days {
+		delete(c.closures, ymd)
+	}
+	c.mu.Unlock()
+	return c
+}
+
+func (a annualClosure) contains(md Md) bool {
+	if a.from <= a.to {
+		return a.from <= md && md <= a.to
+	}
+	return md >= a.from || md <= a.to
+}
+
+func (c *Calendar) holidayName(ymd Ymd) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if name, ok := c.closures[ymd]; ok {
+		return name, true
+	}
+	md := ymd.MonthDay()
+	for _, a := range c.annual {
+		if a.contains(md) {
+			return a.name, true
+		}
+	}
+	if c.national {
+		return JapaneseHoliday(ymd)
+	}
+	return "", false
+}
+
+// HolidayName 休日（週休日を除く）の場合は名称を返します
+func (c *Calendar) HolidayName(ymd Ymd) (string, bool) {
+	if ymd == 0 {
+		return "", false
+	}
+	return c.holidayName(ymd)
+}
+
+// IsHoliday 休日（週休日を除く）か判定します
+func (c *Calendar) IsHoliday(ymd Ymd) bool {
+	_, ok := c.HolidayName(ymd)
+	return ok
+}
+
+// IsWeekend 週休日か判定します
+func (c *Calendar) IsWeekend(ymd Ymd) bool {
+	if ymd == 0 {
+		return false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.weekend[ymd.Weekday()]
+}
+
+// IsBusinessDay 営業日か判定します
+func (c *Calendar) IsBusinessDay(ymd Ymd) bool {
+	if ymd == 0 {
+		return false
+	}
+	return !c.IsWeekend(ymd) && !c.IsHoliday(ymd)
+}
+
+// Holidays 指定した年の休日（週休日を除く）を日付順に取得します
+func (c *Calendar) Holidays(y int) []Holiday {
+	v := HolidaySlice{}
+	for ymd, last := Ymd(y*10000+101), Ymd(y*10000+1231); ymd <= last; ymd = ymd.Next() {
+		if name, ok := c.holidayName(ymd); ok {
+			v = append(v, Holiday{Ymd: ymd, Name: name})
+		}
+	}
+	return v
+}
+
+func (c *Calendar) step(ymd Ymd, d int) Ymd {
+	for i := 0; i < maxBusinessDaySearch; i++ {
+		ymd = ymd.Add(0, 0, d)
+		if c.IsBusinessDay(ymd) {
+			return ymd
+		}
+	}
+	return 0
+}
+
+// NextBusinessDay 翌営業日を取得します。営業日が見つからない場合は0を返します
+func (c *Calendar) NextBusinessDay(ymd Ymd) Ymd {
+	if ymd == 0 {
+		return 0
+	}
+	return c.step(ymd, 1)
+}
+
+// PrevBusinessDay 前営業日を取得します。営業日が見つからない場合は0を返します
+func (c *Calendar) PrevBusinessDay(ymd Ymd) Ymd {
+	if ymd == 0 {
+		return 0
+	}
+	return c.step(ymd, -1)
+}
+
+// AddBusinessDays n営業日後の日付を取得します（減算はマイナス値を引数にセットします）
+//
+//	nが0の場合は引数の日付をそのまま返します
+func (c *Calendar) AddBusinessDays(ymd Ymd, n int) Ymd {
+	if ymd == 0 {
+		return 0
+	}
+	d := 1
+	if n < 0 {
+		d, n = -1, -n
+	}
+	for ; n > 0 && ymd != 0; n-- {
+		ymd = c.step(ymd, d)
+	}
+	return ymd
+}
+
+// BusinessDays 期間内（両端を含む）の営業日数を取得します
+func (c *Calendar) BusinessDays(from, to Ymd) (n int) {
+	if from == 0 || to == 0 {
+		return
+	}
+	for ymd := from; ymd <= to; ymd = ymd.Next() {
+		if c.IsBusinessDay(ymd) {
+			n++
+		}
+	}
+	return
+}
+
+// FirstBusinessDay 月の最初の営業日を取得します。営業日がない場合は0を返します
+func (c *Calendar) FirstBusinessDay(ym Ym) Ymd {
+	if ym == 0 {
+		return 0
+	}
+	for ymd, last := ym.First(), ym.Last(); ymd <= last; ymd = ymd.Next() {
+		if c.IsBusinessDay(ymd) {
+			return ymd
+		}
+	}
+	return 0
+}
+
+// LastBusinessDay 月の最終営業日を取得します。営業日がない場合は0を返します
+func (c *Calendar) LastBusinessDay(ym Ym) Ymd {
+	if ym == 0 {
+		return 0
+	}
+	for ymd, first := ym.Last(), ym.First(); ymd >= first; ymd = ymd.Prev() {
+		if c.IsBusinessDay(ymd) {
+			return ymd
+		}
+	}
+	return 0
+}
+
+// IsBusinessDay 既定のカレンダーで営業日か判定します
+func (ymd Ymd) IsBusinessDay() bool {
+	return DefaultCalendar().IsBusinessDay(ymd)
+}
+
+// IsHoliday 既定のカレンダーで休日（週休日を除く）か判定します
+func (ymd Ymd) IsHoliday() bool {
+	return DefaultCalendar().IsHoliday(ymd)
+}
+
+// AddBusinessDays 既定のカレンダーでn営業日後の日付を取得します（減算はマイナス値を引数にセットします）
+func (ymd Ymd) AddBusinessDays(n int) Ymd {
+	return DefaultCalendar().AddBusinessDays(ymd, n)
+}
+
+// NextBusinessDay 既定のカレンダーで翌営業日を取得します
+func (ymd Ymd) NextBusinessDay() Ymd {
+	return DefaultCalendar().NextBusinessDay(ymd)
+}
+
+// PrevBusinessDay 既定のカレンダーで前営業日を取得します
+func (ymd Ymd) PrevBusinessDay() Ymd {
+	return DefaultCalendar().PrevBusinessDay(ymd)
+}
+
+// FirstBusinessDay 既定のカレンダーで月の最初の営業日を取得します
+func (ym Ym) FirstBusinessDay() Ymd {
+	return DefaultCalendar().FirstBusinessDay(ym)
+}
+
+// LastBusinessDay 既定のカレンダーで月の最終営業日を取得します
+func (ym Ym) LastBusinessDay() Ymd {
+	return DefaultCalendar().LastBusinessDay(ym)
+}
@@ -0,0 +1,118 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestCalendarBusinessDay(t *testing.T) {
+	cal := types.NewCalendar().AddAnnualClosure("年末年始", 1229, 103)
+
+	for _, x := range []struct {
+		ymd Ymd
+		exp bool
+	}{
+		{20240305, true},
+		{20240309, false}, // 土曜日
+		{20240320, false}, // 春分の日
+		{20241230, false}, // 年末年始
+		{20250103, false},
+		{20250106, true},
+	} {
+		if act := cal.IsBusinessDay(x.ymd); act != x.exp {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.ymd, x.exp, act)
+		}
+	}
+
+	for _, x := range []struct {
+		ymd Ymd
+		n   int
+		exp Ymd
+	}{
+		{20240305, 0, 20240305},
+		{20240305, 1, 20240306},
+		{20240319, 1, 20240321},
+		{20240315, 1, 20240318},
+		{20240426, 3, 20240502},
+		{20240502, -3, 20240426},
+		{20241227, 1, 20250106},
+		{20250106, -1, 20241227},
+		{0, 1, 0},
+	} {
+		if act := cal.AddBusinessDays(x.ymd, x.n); act != x.exp {
+			t.Errorf("%v + %d: expect(%v) != actual(%v)", x.ymd, x.n, x.exp, act)
+		}
+	}
+
+	if act := cal.NextBusinessDay(20240503); act != 20240507 {
+		t.Errorf("NextBusinessDay: expect(20240507) != actual(%v)", act)
+	}
+	if act := cal.PrevBusinessDay(20240507); act != 20240502 {
+		t.Errorf("PrevBusinessDay: expect(20240502) != actual(%v)", act)
+	}
+	if act := cal.LastBusinessDay(202412); act != 20241227 {
+		t.Errorf("LastBusinessDay: expect(20241227) != actual(%v)", act)
+	}
+	if act := cal.FirstBusinessDay(202501); act != 20250106 {
+		t.Errorf("FirstBusinessDay: expect(20250106) != actual(%v)", act)
+	}
+	if act := cal.BusinessDays(20240301, 20240331); act != 20 {
+		t.Errorf("BusinessDays: expect(20) != actual(%d)", act)
+	}
+}
+
+func TestCalendarClosure(t *testing.T) {
+	cal := types.NewCalendar().
+		SetWeekend(time.Sunday).
+		AddClosure("創立記念日", 20240610).
+		AddClosureRange("夏季休業", 20240813, 20240816)
+
+	if name, ok := cal.HolidayName(20240610); !ok || name != "創立記念日" {
+		t.Errorf("expect(創立記念日) != actual(%s, %v)", name, ok)
+	}
+	if !cal.IsBusinessDay(20240309) {
+		t.Error("20240309 is business day")
+	}
+	if act := cal.AddBusinessDays(20240812, 1); act != 20240817 {
+		t.Errorf("expect(20240817) != actual(%v)", act)
+	}
+	cal.RemoveClosure(20240610)
+	if cal.IsHoliday(20240610) {
+		t.Error("20240610 is not holiday")
+	}
+
+	if v := cal.Holidays(2024); len(v) != 25 {
+		t.Errorf("Holidays: expect(25) != actual(%d)", len(v))
+	}
+
+	none := types.NewCalendar().SetNational(false).SetWeekend(
+		time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+	if act := none.NextBusinessDay(20240101); act != 0 {
+		t.Errorf("expect(0) != actual(%v)", act)
+	}
+}
+
+func TestYmdBusinessDay(t *testing.T) {
+	org := types.DefaultCalendar()
+	defer types.SetDefaultCalendar(org)
+
+	types.SetDefaultCalendar(types.NewCalendar().AddAnnualClosure("年末年始", 1229, 103))
+
+	if Ymd(20241230).IsBusinessDay() {
+		t.Error("20241230 is not business day")
+	}
+	if act := Ymd(20241227).NextBusinessDay(); act != 20250106 {
+		t.Errorf("expect(20250106) != actual(%v)", act)
+	}
+	if act := Ymd(20250106).PrevBusinessDay(); act != 20241227 {
+		t.Errorf("expect(20241227) != actual(%v)", act)
+	}
+	if act := Ymd(20240305).AddBusinessDays(10); act != 20240319 {
+		t.Errorf("expect(20240319) != actual(%v)", act)
+	}
+	if act := Ym(202403).LastBusinessDay(); act != 20240329 {
+		t.Errorf("expect(20240329) != actual(%v)", act)
+	}
+}
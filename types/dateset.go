@@ -0,0 +1,462 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"math/bits"
+	"strings"
+	"time"
+
+	"github.com/MineTakaki/go-utils/conv"
+	"github.com/MineTakaki/go-utils/errors"
+	"go.uber.org/zap/zapcore"
+)
+
+// DateSet 日付の集合（ビットマップで保持します）
+//
+//	グレゴリウス暦1年1月1日からの経過日数（Ymd.Days）をビットの位置とし、
+//	最小の日付から最大の日付までの範囲を64日単位のワードで保持します。
+//	先頭と末尾のワードは常に空でない状態に保ちます。ゼロ値は空の集合として使用できます。代入した値は内部のバッファを共有するため、複製にはCloneを使用します
+type DateSet struct {
+	base  int // words[0]のワード位置（経過日数 / 64）
+	words []uint64
+}
+
+// バイナリ形式のバージョン
+const dateSetBinaryVersion = 1
+
+// NewDateSet 日付を指定して集合を生成します
+func NewDateSet(v ...Ymd) DateSet {
+	s := DateSet{}
+	s.Add(v...)
+	return s
+}
+
+// ymdFromDays グレゴリウス暦1年1月1日からの経過日数をYmd型に変換します
+func ymdFromDays(n int) Ymd {
+	y, m, d := time.Date(1, 1, 1+n, 0, 0, 0, 0, time.UTC).Date()
+	return Ymd(y*10000 + int(m)*100 + d)
+}
+
+// dateSetIndex 日付のビット位置を取得します。存在しない日付の場合はfalseを返します
+func dateSetIndex(ymd Ymd) (int, bool) {
+	if ymd <= 0 {
+		return 0, false
+	}
+	n := ymd.Days()
+	return n, n >= 0 && ymdFromDays(n) == ymd
+}
+
+// grow ビット位置nのワードを含むように拡張します
+func (s *DateSet) grow(n int) {
+	w := n >> 6
+	if len(s.words) == 0 {
+		s.base, s.words = w, make([]uint64, 1)
+		return
+	}
+	if w < s.base {
+		words := make([]uint64, s.base-w+len(s.words))
+		copy(words[s.base-w:], s.words)
+		s.base, s.words = w, words
+	} else if i := w - s.base; i >= len(s.words) {
+		s.words = append(s.words, make([]uint64, i-len(s.words)+1)...)
+	}
+}
+
+// trim 先頭と末尾の空のワードを取り除きます
+func (s *DateSet) trim() {
+	i, j := 0, len(s.words)
+	for i < j && s.words[i] == 0 {
+		i++
+	}
+	for j > i && s.words[j-1] == 0 {
+		j--
+	}
+	if i == j {
+		s.base, s.words = 0, nil
+		return
+	}
+	s.base, s.words = s.base+i, s.words[i:j]
+}
+
+func (s DateSet) bit(n int) bool {
+	i := n>>6 - s.base
+	return i >= 0 && i < len(s.words) && s.words[i]&(1<<(uint(n)&63)) != 0
+}
+
+// Add 日付を追加します（0と存在しない日付は無視します）
+func (s *DateSet) Add(v ...Ymd) {
+	for _, ymd := range v {
+		if n, ok := dateSetIndex(ymd); ok {
+			s.grow(n)
+			s.words[n>>6-s.base] |= 1 << (uint(n) & 63)
+		}
+	}
+}
+
+// AddRange 区間内（両端を含む）の日付を追加します
+func (s *DateSet) AddRange(r YmdRange) {
+	f, ok1 := dateSetIndex(r.From)
+	t, ok2 := dateSetIndex(r.To)
+	if !ok1 || !ok2 || f > t {
+		return
+	}
+	s.grow(f)
+	s.grow(t)
+	for n := f; n <= t; n++ {
+		s.words[n>>6-s.base] |= 1 << (uint(n) & 63)
+	}
+}
+
+// Remove 日付を取り除きます
+func (s *DateSet) Remove(v ...Ymd) {
+	for _, ymd := range v {
+		if n, ok := dateSetIndex(ymd); ok && s.bit(n) {
+			s.words[n>>6-s.base] &^= 1 << (uint(n) & 63)
+		}
+	}
+	s.trim()
+}
+
+// Contains 日付が含まれるか判定します
+func (s DateSet) Contains(ymd Ymd) bool {
+	n, ok := dateSetIndex(ymd)
+	return ok && s.bit(n)
+}
+
+// Len 日付の数を取得します
+func (s DateSet) Len() (n int) {
+	for _, w := range s.words {
+		n += bits.OnesCount64(w)
+	}
+	return
+}
+
+// IsEmpty 空の集合か判定します
+func (s DateSet) IsEmpty() bool {
+	for _, w := range s.words {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone 複製を取得します
+func (s DateSet) Clone() DateSet {
+	if len(s.words) == 0 {
+		return DateSet{}
+	}
+	return DateSet{base: s.base, words: append([]uint64(nil), s.words...)}
+}
+
+// Equal 同じ日付を含むか判定します
+func (s DateSet) Equal(o DateSet) bool {
+	if s.base != o.base || len(s.words) != len(o.words) {
+		return false
+	}
+	for i, w := range s.words {
+		if w != o.words[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// First 最小の日付を取得します（空の場合は0）
+func (s DateSet) First() Ymd {
+	for i, w := range s.words {
+		if w != 0 {
+			return ymdFromDays((s.base+i)<<6 + bits.TrailingZeros64(w))
+		}
+	}
+	return 0
+}
+
+// Last 最大の日付を取得します（空の場合は0）
+func (s DateSet) Last() Ymd {
+	for i := len(s.words) - 1; i >= 0; i-- {
+		if w := s.words[i]; w != 0 {
+			return ymdFromDays((s.base+i)<<6 + 63 - bits.LeadingZeros64(w))
+		}
+	}
+	return 0
+}
+
+// combine ワード単位の演算で新しい集合を生成します
+func (s DateSet) combine(o DateSet, op func(a, b uint64) uint64) DateSet {
+	if len(s.words) == 0 && len(o.words) == 0 {
+		return DateSet{}
+	}
+	lo, hi := s.base, s.base+len(s.words)
+	if len(s.words) == 0 || (len(o.words) > 0 && o.base < lo) {
+		lo = o.base
+	}
+	if len(s.words) == 0 || o.base+len(o.words) > hi {
+		hi = o.base + len(o.words)
+	}
+	word := func(x DateSet, i int) uint64 {
+		if i -= x.base; i >= 0 && i < len(x.words) {
+			return x.words[i]
+		}
+		return 0
+	}
+	r := DateSet{base: lo, words: make([]uint64, hi-lo)}
+	for i := range r.words {
+		r.words[i] = op(word(s, lo+i), word(o, lo+i))
+	}
+	r.trim()
+	return r
+}
+
+// Union 和集合を取得します
+func (s DateSet) Union(o DateSet) DateSet {
+	return s.combine(o, func(a, b uint64) uint64 { return a | b })
+}
+
+// Intersect 積集合を取得します
+func (s DateSet) Intersect(o DateSet) DateSet {
+	return s.combine(o, func(a, b uint64) uint64 { return a & b })
+}
+
+// Difference 差集合（sに含まれoに含まれない日付）を取得します
+func (s DateSet) Difference(o DateSet) DateSet {
+	return s.combine(o, func(a, b uint64) uint64 { return a &^ b })
+}
+
+// each fromからtoまで（両端を含む、経過日数）のビット位置を昇順に処理します
+func (s DateSet) each(from, to int, fn func(n int) bool) {
+	for i, w := range s.words {
+		for w != 0 {
+			n := (s.base+i)<<6 + bits.TrailingZeros64(w)
+			w &= w - 1
+			if n > to {
+				return
+			}
+			if n >= from && !fn(n) {
+				return
+			}
+		}
+	}
+}
+
+// Each 日付を昇順に処理します。fnがfalseを返した場合は中断します
+func (s DateSet) Each(fn func(Ymd) bool) {
+	s.each(0, int(^uint(0)>>1), func(n int) bool {
+		return fn(ymdFromDays(n))
+	})
+}
+
+// Slice 日付を昇順のスライスで取得します
+func (s DateSet) Slice() YmdSlice {
+	v := make(YmdSlice, 0, s.Len())
+	s.Each(func(ymd Ymd) bool {
+		v = append(v, ymd)
+		return true
+	})
+	return v
+}
+
+// daysRange 区間の経過日数を取得します（From, Toが0の場合はその側の制限なし）
+func (r YmdRange) daysRange() (from, to int) {
+	from, to = 0, int(^uint(0)>>1)
+	if r.From != 0 {
+		from = r.From.Days()
+	}
+	if r.To != 0 {
+		to = r.To.Days()
+	}
+	return
+}
+
+// Count 区間内（両端を含む）の日付の数を取得します
+func (s DateSet) Count(r YmdRange) (n int) {
+	from, to := r.daysRange()
+	for i, w := range s.words {
+		lo, hi := (s.base+i)<<6, (s.base+i)<<6+63
+		if hi < from || lo > to {
+			continue
+		}
+		if lo < from {
+			w &^= 1<<uint(from-lo) - 1
+		}
+		if hi > to {
+			w &= 1<<uint(to-lo+1) - 1
+		}
+		n += bits.OnesCount64(w)
+	}
+	return
+}
+
+// Ranges 連続する日付を区間にまとめて取得します
+func (s DateSet) Ranges() (v []YmdRange) {
+	start, prev := -1, -1
+	flush := func() {
+		if start >= 0 {
+			v = append(v, YmdRange{From: ymdFromDays(start), To: ymdFromDays(prev)})
+		}
+	}
+	s.each(0, int(^uint(0)>>1), func(n int) bool {
+		if n != prev+1 || start < 0 {
+			flush()
+			start = n
+		}
+		prev = n
+		return true
+	})
+	flush()
+	return
+}
+
+// String string型変換（連続する日付は区間で表します）
+func (s DateSet) String() string {
+	v := s.Ranges()
+	a := make([]string, len(v))
+	for i, r := range v {
+		if r.From == r.To {
+			a[i] = r.From.String()
+		} else {
+			a[i] = r.String()
+		}
+	}
+	return "{" + strings.Join(a, ",") + "}"
+}
+
+// MarshalBinary encoding.BinaryMarshalerの実装
+//
+//	バージョン(1バイト)、先頭のワード位置(uvarint)、ワード数(uvarint)、ワード(各8バイト、リトルエンディアン)の形式です
+func (s DateSet) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 1, 1+2*binary.MaxVarintLen64+8*len(s.words))
+	b[0] = dateSetBinaryVersion
+	tmp := make([]byte, binary.MaxVarintLen64)
+	b = append(b, tmp[:binary.PutUvarint(tmp, uint64(s.base))]...)
+	b = append(b, tmp[:binary.PutUvarint(tmp, uint64(len(s.words)))]...)
+	for _, w := range s.words {
+		binary.LittleEndian.PutUint64(tmp, w)
+		b = append(b, tmp[:8]...)
+	}
+	return b, nil
+}
+
+// UnmarshalBinary encoding.BinaryUnmarshalerの実装
+func (s *DateSet) UnmarshalBinary(b []byte) error {
+	if len(b) == 0 || b[0] != dateSetBinaryVersion {
+		return errors.Wrapf(ErrValidate, "incorrect binary data : %x", b)
+	}
+	p := b[1:]
+	base, n1 := binary.Uvarint(p)
+	if n1 <= 0 {
+		return errors.Wrapf(ErrValidate, "incorrect binary data : %x", b)
+	}
+	size, n2 := binary.Uvarint(p[n1:])
+	if n2 <= 0 {
+		return errors.Wrapf(ErrValidate, "incorrect binary data : %x", b)
+	}
+	p = p[n1+n2:]
+	// size*8 は桁あふれするため、残りのバイト数から求めたワード数と比較します
+	if len(p)%8 != 0 || uint64(len(p)/8) != size {
+		return errors.Wrapf(ErrValidate, "incorrect binary data : %x", b)
+	}
+	// 先頭のワード位置は最大の日付（9999/12/31）のワードまでに制限します
+	if maxWord := uint64(Ymd(99991231).Days() >> 6); base > maxWord || base+size > maxWord+1 {
+		return errors.Wrapf(ErrValidate, "incorrect binary data : %x", b)
+	}
+	x := DateSet{base: int(base), words: make([]uint64, size)}
+	for i := range x.words {
+		x.words[i] = binary.LittleEndian.Uint64(p[i*8:])
+	}
+	x.trim()
+	*s = x
+	return nil
+}
+
+// MarshalJSON json.Marshalerの実装
+//
+//	連続する日付は[開始日,終了日]、単独の日付は数値で表します（[[20240101,20240103],20240110]）
+func (s DateSet) MarshalJSON() ([]byte, error) {
+	v := []interface{}{}
+	for _, r := range s.Ranges() {
+		if r.From == r.To {
+			v = append(v, int(r.From))
+		} else {
+			v = append(v, [2]int{int(r.From), int(r.To)})
+		}
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON json.Unmarshalerインターフェイスの実装
+func (s *DateSet) UnmarshalJSON(b []byte) (err error) {
+	var v []interface{}
+	if err = errors.WithStack(json.Unmarshal(b, &v)); err != nil {
+		return
+	}
+	x := DateSet{}
+	for _, e := range v {
+		var r YmdRange
+		if a, ok := e.([]interface{}); ok {
+			if len(a) != 2 {
+				return errors.Wrapf(ErrValidate, "incorrect range : %v", a)
+			}
+			if r.From, err = scanDateSetElement(a[0]); err != nil {
+				return
+			}
+			if r.To, err = scanDateSetElement(a[1]); err != nil {
+				return
+			}
+		} else {
+			if r.From, err = scanDateSetElement(e); err != nil {
+				return
+			}
+			r.To = r.From
+		}
+		x.AddRange(r)
+	}
+	*s = x
+	return nil
+}
+
+// scanDateSetElement JSON形式の要素を読み取ります（Addと同様に年の範囲は問いません）
+func scanDateSetElement(i interface{}) (ymd Ymd, err error) {
+	if err = ymd.Scan(i); err != nil {
+		return
+	}
+	if _, ok := dateSetIndex(ymd); !ok {
+		return 0, errors.Wrapf(ErrValidate, "incorrect date value : %v", i)
+	}
+	return
+}
+
+// Scan 集合を読み取ります（バイナリ形式またはJSON形式）
+func (s *DateSet) Scan(i interface{}) error {
+	if conv.IsEmpty(i) {
+		*s = DateSet{}
+		return nil
+	}
+	switch v := i.(type) {
+	case []byte:
+		if len(v) > 0 && v[0] == dateSetBinaryVersion {
+			return s.UnmarshalBinary(v)
+		}
+		return s.UnmarshalJSON(v)
+	case string:
+		return s.UnmarshalJSON([]byte(v))
+	}
+	return errors.Wrapf(ErrUnkownType, "%T", i)
+}
+
+// Value driver.Valuerインターフェイスの実装（バイナリ形式）
+func (s DateSet) Value() (driver.Value, error) {
+	if s.IsEmpty() {
+		return nil, nil
+	}
+	return s.MarshalBinary()
+}
+
+// MarshalLogObject zapcore.ObjectMarshalerの実装
+func (s DateSet) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt("len", s.Len())
+	enc.AddString("dates", s.String())
+	return nil
+}
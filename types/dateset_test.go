@@ -0,0 +1,150 @@
+package types_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/MineTakaki/go-utils/errors"
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestDateSet(t *testing.T) {
+	s := types.NewDateSet(20240305, 20240101, 20231231, 0, 20240230)
+	s.AddRange(types.YmdRange{From: 20240501, To: 20240503})
+	if s.Len() != 6 {
+		t.Errorf("expect(%v) != actual(%v)", 6, s.Len())
+	}
+	for _, x := range []struct {
+		ymd types.Ymd
+		exp bool
+	}{
+		{20240305, true}, {20240101, true}, {20231231, true}, {20240502, true},
+		{20240304, false}, {20240230, false}, {0, false}, {20990101, false},
+	} {
+		if act := s.Contains(x.ymd); act != x.exp {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.ymd, x.exp, act)
+		}
+	}
+	if act := s.First(); act != 20231231 {
+		t.Errorf("expect(%v) != actual(%v)", 20231231, act)
+	}
+	if act := s.Last(); act != 20240503 {
+		t.Errorf("expect(%v) != actual(%v)", 20240503, act)
+	}
+	exp := types.YmdSlice{20231231, 20240101, 20240305, 20240501, 20240502, 20240503}
+	if act := s.Slice(); !reflect.DeepEqual(act, exp) {
+		t.Errorf("expect(%v) != actual(%v)", exp, act)
+	}
+	if act := s.Count(types.YmdRange{From: 20240101, To: 20240502}); act != 4 {
+		t.Errorf("expect(%v) != actual(%v)", 4, act)
+	}
+	if act := s.Count(types.YmdRange{From: 20240102}); act != 4 {
+		t.Errorf("expect(%v) != actual(%v)", 4, act)
+	}
+	if act := s.String(); act != "{20231231-20240101,20240305,20240501-20240503}" {
+		t.Errorf("actual(%v)", act)
+	}
+
+	s.Remove(20231231, 20240101)
+	if act := s.First(); act != 20240305 || s.Contains(20231231) {
+		t.Errorf("expect(%v) != actual(%v)", 20240305, act)
+	}
+}
+
+func TestDateSetOperation(t *testing.T) {
+	a := types.NewDateSet(20240101, 20240102, 20240103, 20250101)
+	b := types.NewDateSet(20240103, 20240104, 20200101)
+	for _, x := range []struct {
+		act types.DateSet
+		exp types.YmdSlice
+	}{
+		{a.Union(b), types.YmdSlice{20200101, 20240101, 20240102, 20240103, 20240104, 20250101}},
+		{a.Intersect(b), types.YmdSlice{20240103}},
+		{a.Difference(b), types.YmdSlice{20240101, 20240102, 20250101}},
+		{b.Difference(a), types.YmdSlice{20200101, 20240104}},
+		{a.Intersect(types.DateSet{}), types.YmdSlice{}},
+		{types.DateSet{}.Union(b), types.YmdSlice{20200101, 20240103, 20240104}},
+	} {
+		if act := x.act.Slice(); !reflect.DeepEqual(act, x.exp) {
+			t.Errorf("expect(%v) != actual(%v)", x.exp, act)
+		}
+	}
+	if !a.Union(b).Difference(b).Equal(a.Difference(b)) {
+		t.Errorf("not equal")
+	}
+}
+
+func TestDateSetSerialize(t *testing.T) {
+	s := types.NewDateSet(20240101, 20240102, 20240103, 20240110, 20250101)
+
+	b, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var act types.DateSet
+	if err = act.UnmarshalBinary(b); err != nil || !act.Equal(s) {
+		t.Errorf("expect(%v) != actual(%v) %v", s, act, err)
+	}
+	if err = act.UnmarshalBinary(b[:len(b)-1]); err == nil {
+		t.Errorf("error expected")
+	}
+	// ワード数 2^61 （8倍すると桁あふれする値）でデータなし
+	if err = act.UnmarshalBinary([]byte{b[0], 0, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x20}); !errors.Is(err, types.ErrValidate) {
+		t.Errorf("expect ErrValidate, actual %v", err)
+	}
+	// 先頭のワード位置が最大の日付を超えるデータ
+	maxWord := uint64(types.Ymd(99991231).Days() >> 6)
+	for _, x := range []struct{ base, size uint64 }{{1 << 62, 1}, {maxWord + 1, 1}, {maxWord, 2}} {
+		tmp := make([]byte, binary.MaxVarintLen64)
+		p := append([]byte{b[0]}, tmp[:binary.PutUvarint(tmp, x.base)]...)
+		p = append(p, tmp[:binary.PutUvarint(tmp, x.size)]...)
+		p = append(p, make([]byte, 8*x.size)...)
+		p[len(p)-8] = 1
+		if err = act.UnmarshalBinary(p); !errors.Is(err, types.ErrValidate) {
+			t.Errorf("base %d, size %d: expect ErrValidate, actual %v (%v)", x.base, x.size, err, act)
+		}
+	}
+
+	b, _ = json.Marshal(s)
+	if exp := `[[20240101,20240103],20240110,20250101]`; string(b) != exp {
+		t.Errorf("expect(%s) != actual(%s)", exp, b)
+	}
+	act = types.DateSet{}
+	if err = json.Unmarshal(b, &act); err != nil || !act.Equal(s) {
+		t.Errorf("expect(%v) != actual(%v) %v", s, act, err)
+	}
+
+	// 年の範囲によらず読み戻せます
+	old := types.NewDateSet(19900101, 19900102, 30000101)
+	b, _ = json.Marshal(old)
+	act = types.DateSet{}
+	if err = json.Unmarshal(b, &act); err != nil || !act.Equal(old) {
+		t.Errorf("expect(%v) != actual(%v) %v", old, act, err)
+	}
+	act = types.DateSet{}
+	if err = act.Scan(string(b)); err != nil || !act.Equal(old) {
+		t.Errorf("expect(%v) != actual(%v) %v", old, act, err)
+	}
+	for _, src := range []string{`[20240230]`, `[[20240101,0]]`, `["abc"]`} {
+		if err = act.Scan(src); !errors.Is(err, types.ErrValidate) {
+			t.Errorf("%s: expect ErrValidate, actual %v", src, err)
+		}
+	}
+
+	v, _ := s.Value()
+	act = types.DateSet{}
+	if err = act.Scan(v); err != nil || !act.Equal(s) {
+		t.Errorf("expect(%v) != actual(%v) %v", s, act, err)
+	}
+	if err = act.Scan(`["2024-01-01",[20240102,20240103]]`); err != nil || act.Len() != 3 {
+		t.Errorf("%v %+v", act, err)
+	}
+	if err = act.Scan(nil); err != nil || !act.IsEmpty() {
+		t.Errorf("%v %+v", act, err)
+	}
+	if v, _ = act.Value(); v != nil {
+		t.Errorf("expect(nil) != actual(%v)", v)
+	}
+}
@@ -0,0 +1,167 @@
+package decimal
+
+import (
+	"github.com/MineTakaki/go-utils/errors"
+)
+
+// RoundingMode specifies how a value is rounded to a given number of decimal places.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest neighbor, ties away from zero (四捨五入).
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfDown rounds to the nearest neighbor, ties towards zero (五捨六入).
+	RoundHalfDown
+	// RoundHalfEven rounds to the nearest neighbor, ties to the even neighbor (銀行丸め).
+	RoundHalfEven
+	// RoundUp rounds away from zero (切り上げ).
+	RoundUp
+	// RoundDown rounds towards zero (切り捨て).
+	RoundDown
+	// RoundCeiling rounds towards +infinity.
+	RoundCeiling
+	// RoundFloor rounds towards -infinity.
+	RoundFloor
+)
+
+// String implements the fmt.Stringer interface.
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfUp:
+		return "HalfUp"
+	case RoundHalfDown:
+		return "HalfDown"
+	case RoundHalfEven:
+		return "HalfEven"
+	case RoundUp:
+		return "Up"
+	case RoundDown:
+		return "Down"
+	case RoundCeiling:
+		return "Ceiling"
+	case RoundFloor:
+		return "Floor"
+	}
+	return ""
+}
+
+// round adjusts t, the value truncated towards zero at places, according to the mode.
+//
+//	sign is the sign of the exact value, half compares the discarded part with
+//	half a unit (-1, 0, +1) and inexact reports whether anything was discarded.
+func (m RoundingMode) round(t Decimal, places int32, sign, half int, inexact bool) Decimal {
+	if !inexact {
+		return t
+	}
+	var away bool
+	switch m {
+	case RoundHalfUp:
+		away = half >= 0
+	case RoundHalfDown:
+		away = half > 0
+	case RoundHalfEven:
+		away = half > 0 || (half == 0 && t.Shift(places).BigInt().Bit(0) == 1)
+	case RoundUp:
+		away = true
+	case RoundCeiling:
+		away = sign > 0
+	case RoundFloor:
+		away = sign < 0
+	}
+	if !away {
+		return t
+	}
+	return t.Add(New(int64(sign), -places))
+}
+
+// RoundWith rounds the decimal to places decimal places using the rounding mode.
+// If places < 0, it will round the integer part to the nearest 10^(-places).
+//
+// Example:
+//
+//	NewFromFloat(2.5).RoundWith(0, RoundHalfDown).String() // output: "2"
+//	NewFromFloat(2.5).RoundWith(0, RoundHalfEven).String() // output: "2"
+//	NewFromFloat(-2.1).RoundWith(0, RoundFloor).String()   // output: "-3"
+func (d Decimal) RoundWith(places int32, mode RoundingMode) Decimal {
+	t := d.RoundDown(places)
+	r := d.Sub(t).Abs().Shift(places + 1)
+	return mode.round(t, places, d.Sign(), r.Cmp(NewFromInt(5)), !r.IsZero())
+}
+
+// Context carries the precision and rounding mode used by Div, Pow, QuoRem and
+// Round, so that callers can use their own settings without changing
+// DivisionPrecision.
+//
+// The zero value rounds to integers with RoundHalfUp.
+type Context struct {
+	Precision int32        // number of decimal places of the result
+	Rounding  RoundingMode // rounding mode applied at Precision
+}
+
+// NewContext returns a Context with the precision and rounding mode.
+func NewContext(precision int32, mode RoundingMode) Context {
+	return Context{Precision: precision, Rounding: mode}
+}
+
+// DefaultContext returns a Context equivalent to Div, i.e. DivisionPrecision
+// decimal places rounded with RoundHalfUp.
+func DefaultContext() Context {
+	return Context{Precision: int32(DivisionPrecision), Rounding: RoundHalfUp}
+}
+
+// Round rounds d to the precision of the context.
+func (c Context) Round(d Decimal) Decimal {
+	return d.RoundWith(c.Precision, c.Rounding)
+}
+
+// Div returns d / d2 rounded to the precision of the context.
+// The result is rounded exactly, using the remainder of the division.
+func (c Context) Div(d, d2 Decimal) Decimal {
+	q, _ := c.QuoRem(d, d2)
+	return q
+}
+
+// QuoRem returns the quotient q rounded to the precision of the context and
+// the remainder r such that d = d2 * q + r.
+//
+// Unlike Decimal.QuoRem, which always truncates, the sign of r depends on the
+// rounding mode.
+func (c Context) QuoRem(d, d2 Decimal) (Decimal, Decimal) {
+	q, r := d.QuoRem(d2, c.Precision)
+	if !r.IsZero() {
+		half := r.Abs().Shift(c.Precision + 1).Cmp(d2.Abs().Mul(NewFromInt(5)))
+		q = c.Rounding.round(q, c.Precision, d.Sign()*d2.Sign(), half, true)
+		r = d.Sub(d2.Mul(q))
+	}
+	return q, r
+}
+
+// Pow returns d to the power d2 rounded to the precision of the context.
+//
+// Integer exponents are computed exactly before rounding; a negative integer
+// exponent is divided with the rounding of the context. Other exponents are
+// computed with extra digits and then rounded.
+func (c Context) Pow(d, d2 Decimal) (Decimal, error) {
+	if d2.Equal(d2.Truncate(0)) {
+		if d.IsZero() && !d2.IsPositive() {
+			return Decimal{}, errors.Errorf("cannot represent 0 to the power %v", d2)
+		}
+		p, err := d.Decimal.PowBigInt(d2.Abs().BigInt())
+		if err != nil {
+			return Decimal{}, errors.WithStack(err)
+		}
+		if d2.IsNegative() {
+			return c.Div(One, Decimal{p}), nil
+		}
+		return c.Round(Decimal{p}), nil
+	}
+	p, err := d.Decimal.PowWithPrecision(d2.Decimal, c.Precision+powGuardDigits)
+	if err != nil {
+		return Decimal{}, errors.WithStack(err)
+	}
+	return c.Round(Decimal{p}), nil
+}
+
+// powGuardDigits is the number of extra decimal places computed by Context.Pow
+// for non-integer exponents before rounding.
+const powGuardDigits = 8
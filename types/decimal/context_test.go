@@ -0,0 +1,135 @@
+package decimal_test
+
+import (
+	"testing"
+
+	"github.com/MineTakaki/go-utils/types/decimal"
+)
+
+func TestRoundWith(t *testing.T) {
+	modes := []decimal.RoundingMode{
+		decimal.RoundHalfUp, decimal.RoundHalfDown, decimal.RoundHalfEven,
+		decimal.RoundUp, decimal.RoundDown, decimal.RoundCeiling, decimal.RoundFloor,
+	}
+	for _, tc := range []struct {
+		src    string
+		places int32
+		expect [7]string // HalfUp, HalfDown, HalfEven, Up, Down, Ceiling, Floor
+	}{
+		{"5.5", 0, [7]string{"6", "5", "6", "6", "5", "6", "5"}},
+		{"2.5", 0, [7]string{"3", "2", "2", "3", "2", "3", "2"}},
+		{"1.6", 0, [7]string{"2", "2", "2", "2", "1", "2", "1"}},
+		{"1.1", 0, [7]string{"1", "1", "1", "2", "1", "2", "1"}},
+		{"1.0", 0, [7]string{"1", "1", "1", "1", "1", "1", "1"}},
+		{"-1.0", 0, [7]string{"-1", "-1", "-1", "-1", "-1", "-1", "-1"}},
+		{"-1.1", 0, [7]string{"-1", "-1", "-1", "-2", "-1", "-1", "-2"}},
+		{"-1.6", 0, [7]string{"-2", "-2", "-2", "-2", "-1", "-1", "-2"}},
+		{"-2.5", 0, [7]string{"-3", "-2", "-2", "-3", "-2", "-2", "-3"}},
+		{"-5.5", 0, [7]string{"-6", "-5", "-6", "-6", "-5", "-5", "-6"}},
+		{"0.125", 2, [7]string{"0.13", "0.12", "0.12", "0.13", "0.12", "0.13", "0.12"}},
+		{"0.1251", 2, [7]string{"0.13", "0.13", "0.13", "0.13", "0.12", "0.13", "0.12"}},
+		{"1250", -2, [7]string{"1300", "1200", "1200", "1300", "1200", "1300", "1200"}},
+		{"1350", -2, [7]string{"1400", "1300", "1400", "1400", "1300", "1400", "1300"}},
+	} {
+		d := decimal.RequireFromString(tc.src)
+		for i, m := range modes {
+			if act := d.RoundWith(tc.places, m).String(); act != tc.expect[i] {
+				t.Errorf("%s.RoundWith(%d, %v): expect(%v) != actual(%v)", tc.src, tc.places, m, tc.expect[i], act)
+			}
+		}
+	}
+}
+
+func TestContextDiv(t *testing.T) {
+	d := decimal.RequireFromString
+	for _, tc := range []struct {
+		c      decimal.Context
+		a, b   string
+		expect string
+	}{
+		{decimal.NewContext(2, decimal.RoundHalfUp), "2", "3", "0.67"},
+		{decimal.NewContext(2, decimal.RoundDown), "2", "3", "0.66"},
+		{decimal.NewContext(0, decimal.RoundHalfUp), "5", "2", "3"},
+		{decimal.NewContext(0, decimal.RoundHalfDown), "5", "2", "2"},
+		{decimal.NewContext(0, decimal.RoundHalfEven), "5", "2", "2"},
+		{decimal.NewContext(0, decimal.RoundHalfEven), "7", "2", "4"},
+		{decimal.NewContext(0, decimal.RoundHalfDown), "5000001", "1000000", "5"},
+		{decimal.NewContext(0, decimal.RoundHalfDown), "5000001", "2000000", "3"},
+		{decimal.NewContext(0, decimal.RoundCeiling), "-7", "2", "-3"},
+		{decimal.NewContext(0, decimal.RoundFloor), "-7", "2", "-4"},
+		{decimal.NewContext(0, decimal.RoundFloor), "7", "-2", "-4"},
+		{decimal.NewContext(0, decimal.RoundUp), "-7", "-2", "4"},
+		{decimal.NewContext(3, decimal.RoundUp), "1", "8", "0.125"},
+		{decimal.DefaultContext(), "2", "3", "0.6666666666666667"},
+	} {
+		if act := tc.c.Div(d(tc.a), d(tc.b)).String(); act != tc.expect {
+			t.Errorf("%+v %s/%s: expect(%v) != actual(%v)", tc.c, tc.a, tc.b, tc.expect, act)
+		}
+	}
+}
+
+func TestContextQuoRem(t *testing.T) {
+	d := decimal.RequireFromString
+	for _, tc := range []struct {
+		c    decimal.Context
+		a, b string
+		q, r string
+	}{
+		{decimal.NewContext(0, decimal.RoundDown), "7", "2", "3", "1"},
+		{decimal.NewContext(0, decimal.RoundHalfUp), "7", "2", "4", "-1"},
+		{decimal.NewContext(1, decimal.RoundFloor), "-1", "3", "-0.4", "0.2"},
+		{decimal.NewContext(0, decimal.RoundHalfEven), "6", "2", "3", "0"},
+	} {
+		q, r := tc.c.QuoRem(d(tc.a), d(tc.b))
+		if q.String() != tc.q || r.String() != tc.r {
+			t.Errorf("%+v %s/%s: expect(%v, %v) != actual(%v, %v)", tc.c, tc.a, tc.b, tc.q, tc.r, q, r)
+		}
+		if act := d(tc.b).Mul(q).Add(r); !act.Equal(d(tc.a)) {
+			t.Errorf("%+v %s/%s: b*q+r expect(%v) != actual(%v)", tc.c, tc.a, tc.b, tc.a, act)
+		}
+	}
+}
+
+func TestContextPow(t *testing.T) {
+	d := decimal.RequireFromString
+	for _, tc := range []struct {
+		c      decimal.Context
+		a, b   string
+		expect string
+		err    bool
+	}{
+		{decimal.NewContext(2, decimal.RoundHalfUp), "1.05", "3", "1.16", false},
+		{decimal.NewContext(2, decimal.RoundUp), "1.05", "3", "1.16", false},
+		{decimal.NewContext(4, decimal.RoundDown), "1.05", "3", "1.1576", false},
+		{decimal.NewContext(3, decimal.RoundHalfUp), "2", "-3", "0.125", false},
+		{decimal.NewContext(2, decimal.RoundHalfEven), "2", "-3", "0.12", false},
+		{decimal.NewContext(2, decimal.RoundHalfUp), "3", "0", "1", false},
+		{decimal.NewContext(4, decimal.RoundHalfUp), "2", "0.5", "1.4142", false},
+		{decimal.NewContext(4, decimal.RoundUp), "2", "0.5", "1.4143", false},
+		{decimal.NewContext(2, decimal.RoundHalfUp), "0", "-1", "", true},
+		{decimal.NewContext(2, decimal.RoundHalfUp), "0", "0", "", true},
+	} {
+		act, err := tc.c.Pow(d(tc.a), d(tc.b))
+		if tc.err {
+			if err == nil {
+				t.Errorf("%s^%s: expect error, actual %v", tc.a, tc.b, act)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s^%s: %+v", tc.a, tc.b, err)
+		} else if act.String() != tc.expect {
+			t.Errorf("%+v %s^%s: expect(%v) != actual(%v)", tc.c, tc.a, tc.b, tc.expect, act)
+		}
+	}
+}
+
+func TestContextRound(t *testing.T) {
+	c := decimal.NewContext(1, decimal.RoundHalfEven)
+	if act := c.Round(decimal.RequireFromString("0.25")).String(); act != "0.2" {
+		t.Errorf("expect(%v) != actual(%v)", "0.2", act)
+	}
+	if act := (decimal.Context{}).Round(decimal.RequireFromString("2.5")).String(); act != "3" {
+		t.Errorf("expect(%v) != actual(%v)", "3", act)
+	}
+}
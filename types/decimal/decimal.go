@@ -79,6 +79,8 @@ func (d DecimalSlice) Less(i, j int) bool { return d[i].LessThan(d[j]) }
 func (d DecimalSlice) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
 
 // Format fmt.Formatterインターフェイスの実装
+//
+//	%v, %s, %q の他に %f（%.2f 等で小数部の桁数を指定）、%n（3桁区切り、%#n は万・億等の単位）を使用できます
 func (d Decimal) Format(s fmt.State, verb rune) {
 	switch verb {
 	case 'v':
@@ -92,6 +94,8 @@ func (d Decimal) Format(s fmt.State, verb rune) {
 		io.WriteString(s, d.String())
 	case 'q':
 		fmt.Fprintf(s, "%q", d.String())
+	case 'f', 'F', 'n':
+		formatVerb(s, verb, d)
 	}
 }
 
@@ -115,7 +119,8 @@ func (d *Decimal) Scan(value interface{}) error {
 	return errors.Wrapf(ErrScan, "scan value error: %v", value)
 }
 
-// MarshalLogObject implements of zapcore.ObjectMarshaler interface.
+// MarshalLogObject implements of zapcore.ObjectMarshaler interface ({"decimal":"12.5"}).
+// Use zapx.Decimal for the plain string form that LogValue writes.
 func (d Decimal) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("decimal", d.String())
 	return nil
@@ -391,6 +396,50 @@ func (d Decimal) Round(places int32) Decimal {
 	return Decimal{d.Decimal.Round(places)}
 }
 
+// RoundCeil rounds the decimal towards +infinity.
+//
+// Example:
+//
+//	NewFromFloat(545).RoundCeil(-2).String()   // output: "600"
+//	NewFromFloat(1.1001).RoundCeil(2).String() // output: "1.11"
+//	NewFromFloat(-1.454).RoundCeil(1).String() // output: "-1.4"
+func (d Decimal) RoundCeil(places int32) Decimal {
+	return Decimal{d.Decimal.RoundCeil(places)}
+}
+
+// RoundFloor rounds the decimal towards -infinity.
+//
+// Example:
+//
+//	NewFromFloat(545).RoundFloor(-2).String()   // output: "500"
+//	NewFromFloat(1.1001).RoundFloor(2).String() // output: "1.1"
+//	NewFromFloat(-1.454).RoundFloor(1).String() // output: "-1.5"
+func (d Decimal) RoundFloor(places int32) Decimal {
+	return Decimal{d.Decimal.RoundFloor(places)}
+}
+
+// RoundUp rounds the decimal away from zero.
+//
+// Example:
+//
+//	NewFromFloat(545).RoundUp(-2).String()   // output: "600"
+//	NewFromFloat(1.1001).RoundUp(2).String() // output: "1.11"
+//	NewFromFloat(-1.454).RoundUp(1).String() // output: "-1.5"
+func (d Decimal) RoundUp(places int32) Decimal {
+	return Decimal{d.Decimal.RoundUp(places)}
+}
+
+// RoundDown rounds the decimal towards zero.
+//
+// Example:
+//
+//	NewFromFloat(545).RoundDown(-2).String()   // output: "500"
+//	NewFromFloat(1.1001).RoundDown(2).String() // output: "1.1"
+//	NewFromFloat(-1.454).RoundDown(1).String() // output: "-1.4"
+func (d Decimal) RoundDown(places int32) Decimal {
+	return Decimal{d.Decimal.RoundDown(places)}
+}
+
 // RoundBank rounds the decimal to places decimal places.
 // If the final digit to round is equidistant from the nearest two integers the
 // rounded value is taken as the even number
@@ -0,0 +1,187 @@
+package decimal
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// SignStyle specifies how negative values are written by Formatter.
+type SignStyle int
+
+const (
+	// SignMinus writes negative values with a leading "-" (-1,234).
+	SignMinus SignStyle = iota
+	// SignTriangle writes negative values with a leading "△" (△1,234), as in Japanese accounting.
+	SignTriangle
+	// SignBlackTriangle writes negative values with a leading "▲" (▲1,234), as in Japanese accounting.
+	SignBlackTriangle
+	// SignParen encloses negative values in parentheses ((1,234)).
+	SignParen
+)
+
+// kanjiUnits are the magnitude units of 10^4 steps used by Formatter.Kanji.
+var kanjiUnits = []string{"", "万", "億", "兆", "京", "垓"}
+
+// Formatter formats decimals for display.
+//
+// The zero value writes the plain number without grouping, rounded to an integer.
+//
+// Example:
+//
+//	f := Formatter{Group: ",", Sign: SignTriangle, Suffix: "円"}
+//	f.Format(RequireFromString("-1234567")) // output: "△1,234,567円"
+//	f.Kanji = true
+//	f.Format(RequireFromString("123450000")) // output: "1億2,345万円"
+type Formatter struct {
+	Group    string       // grouping separator of the integer part ("," etc.). Empty for no grouping
+	Places   int32        // number of decimal places
+	Minimum  bool         // treat Places as the minimum, keeping further digits of the value instead of rounding
+	Rounding RoundingMode // rounding mode used when the value has more than Places decimal places
+	Sign     SignStyle    // how negative values are written
+	Prefix   string       // written before the number, e.g. "¥", "$"
+	Suffix   string       // written after the number, e.g. "円"
+	Kanji    bool         // write magnitudes with kanji units (万, 億, 兆, ...)
+	Null     string       // placeholder written for NULL by FormatNull
+}
+
+// Format returns d formatted with the options of the formatter.
+func (f Formatter) Format(d Decimal) string {
+	var s string
+	if f.Minimum {
+		s = d.Abs().String()
+	} else {
+		d = d.RoundWith(f.Places, f.Rounding)
+		s = d.Abs().StringFixed(f.Places)
+	}
+	num, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		num, frac = s[:i], s[i+1:]
+	}
+	if n := int(f.Places) - len(frac); n > 0 {
+		frac += strings.Repeat("0", n)
+	}
+
+	var b strings.Builder
+	if f.Kanji {
+		f.writeKanji(&b, num, frac != "")
+	} else {
+		b.WriteString(group(num, f.Group))
+	}
+	if frac != "" {
+		b.WriteByte('.')
+		b.WriteString(frac)
+	}
+	body := f.Prefix + b.String() + f.Suffix
+
+	if d.Sign() >= 0 {
+		return body
+	}
+	switch f.Sign {
+	case SignTriangle:
+		return "△" + body
+	case SignBlackTriangle:
+		return "▲" + body
+	case SignParen:
+		return "(" + body + ")"
+	}
+	return "-" + body
+}
+
+// FormatNull returns d formatted with the options of the formatter, or the
+// Null placeholder when d is NULL.
+func (f Formatter) FormatNull(d NullDecimal) string {
+	if !d.Valid {
+		return f.Null
+	}
+	return f.Format(d.Decimal)
+}
+
+// writeKanji writes the integer part split into 4-digit groups with kanji units.
+// Groups of zero are omitted; the lowest group is written as "0" only when it
+// is followed by a fraction or the whole value is zero. Digits above the largest
+// unit are kept in its group.
+func (f Formatter) writeKanji(b *strings.Builder, num string, hasFrac bool) {
+	var groups []string
+	for len(num) > 4 && len(groups) < len(kanjiUnits)-1 {
+		groups = append(groups, num[len(num)-4:])
+		num = num[:len(num)-4]
+	}
+	groups = append(groups, num)
+
+	written := false
+	for i := len(groups) - 1; i >= 0; i-- {
+		g := strings.TrimLeft(groups[i], "0")
+		if g == "" {
+			if i == 0 && (!written || hasFrac) {
+				b.WriteByte('0')
+			}
+			continue
+		}
+		b.WriteString(group(g, f.Group))
+		b.WriteString(kanjiUnits[i])
+		written = true
+	}
+}
+
+// group inserts sep between every three digits of the integer string.
+func group(num, sep string) string {
+	if sep == "" || len(num) <= 3 {
+		return num
+	}
+	var b strings.Builder
+	n := len(num) % 3
+	if n == 0 {
+		n = 3
+	}
+	b.WriteString(num[:n])
+	for i := n; i < len(num); i += 3 {
+		b.WriteString(sep)
+		b.WriteString(num[i : i+3])
+	}
+	return b.String()
+}
+
+// FormatWith returns d formatted with the formatter.
+func (d Decimal) FormatWith(f Formatter) string {
+	return f.Format(d)
+}
+
+// FormatWith returns d formatted with the formatter, or f.Null when d is NULL.
+func (d NullDecimal) FormatWith(f Formatter) string {
+	return f.FormatNull(d)
+}
+
+// formatVerb writes d for the numeric verbs of Format.
+//
+//	%f, %.2f  fixed decimal places (the value as it is without precision)
+//	%n, %.2n  grouped with "," (%#n writes kanji units)
+//
+// The width and the '-' flag pad the result to the width.
+func formatVerb(s fmt.State, verb rune, d Decimal) {
+	prec, hasPrec := s.Precision()
+	var str string
+	switch verb {
+	case 'f', 'F':
+		if hasPrec {
+			str = d.StringFixed(int32(prec))
+		} else {
+			str = d.String()
+		}
+	case 'n':
+		f := Formatter{Group: ",", Places: int32(prec), Minimum: !hasPrec, Kanji: s.Flag('#')}
+		str = f.Format(d)
+	}
+	if w, ok := s.Width(); ok {
+		if n := w - utf8.RuneCountInString(str); n > 0 {
+			pad := strings.Repeat(" ", n)
+			if s.Flag('-') {
+				str += pad
+			} else {
+				str = pad + str
+			}
+		}
+	}
+	io.WriteString(s, str)
+}
@@ -0,0 +1,109 @@
+package decimal_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MineTakaki/go-utils/types/decimal"
+)
+
+func TestFormatter(t *testing.T) {
+	comma := decimal.Formatter{Group: ","}
+	for _, tc := range []struct {
+		f      decimal.Formatter
+		src    string
+		expect string
+	}{
+		{decimal.Formatter{}, "1234567.5", "1234568"},
+		{comma, "1234567", "1,234,567"},
+		{comma, "123", "123"},
+		{comma, "-1234", "-1,234"},
+		{comma, "0", "0"},
+		{decimal.Formatter{Group: ",", Places: 2}, "1234.5", "1,234.50"},
+		{decimal.Formatter{Group: ",", Places: 2}, "1234.567", "1,234.57"},
+		{decimal.Formatter{Group: ",", Places: 2, Rounding: decimal.RoundDown}, "1234.567", "1,234.56"},
+		{decimal.Formatter{Group: ",", Places: 2}, "-0.001", "0.00"},
+		{decimal.Formatter{Group: ",", Places: 2, Minimum: true}, "1234.5", "1,234.50"},
+		{decimal.Formatter{Group: ",", Places: 2, Minimum: true}, "1234.5678", "1,234.5678"},
+		{decimal.Formatter{Group: ",", Places: 0, Minimum: true}, "1234.500", "1,234.5"},
+		{decimal.Formatter{Group: ",", Sign: decimal.SignTriangle}, "-1234", "△1,234"},
+		{decimal.Formatter{Group: ",", Sign: decimal.SignBlackTriangle}, "-1234", "▲1,234"},
+		{decimal.Formatter{Group: ",", Sign: decimal.SignParen}, "-1234", "(1,234)"},
+		{decimal.Formatter{Group: ",", Sign: decimal.SignParen}, "1234", "1,234"},
+		{decimal.Formatter{Group: ",", Prefix: "¥"}, "-1000", "-¥1,000"},
+		{decimal.Formatter{Group: ",", Prefix: "$", Places: 2, Sign: decimal.SignParen}, "-12.5", "($12.50)"},
+		{decimal.Formatter{Group: ",", Suffix: "円", Sign: decimal.SignTriangle}, "-500", "△500円"},
+		{decimal.Formatter{Group: ".", Places: 2}, "1234567", "1.234.567.00"},
+	} {
+		if act := tc.f.Format(decimal.RequireFromString(tc.src)); act != tc.expect {
+			t.Errorf("%s %+v: expect(%v) != actual(%v)", tc.src, tc.f, tc.expect, act)
+		}
+	}
+}
+
+func TestFormatterKanji(t *testing.T) {
+	f := decimal.Formatter{Group: ",", Kanji: true, Suffix: "円"}
+	for _, tc := range []struct {
+		src    string
+		expect string
+	}{
+		{"123450000", "1億2,345万円"},
+		{"100000000", "1億円"},
+		{"100230000", "1億23万円"},
+		{"12345", "1万2,345円"},
+		{"9999", "9,999円"},
+		{"0", "0円"},
+		{"-50000", "-5万円"},
+		{"1234567890123", "1兆2,345億6,789万123円"},
+		{"123456789012345678901234567", "1,234,567垓8,901京2,345兆6,789億123万4,567円"},
+	} {
+		if act := f.Format(decimal.RequireFromString(tc.src)); act != tc.expect {
+			t.Errorf("%s: expect(%v) != actual(%v)", tc.src, tc.expect, act)
+		}
+	}
+
+	f = decimal.Formatter{Kanji: true, Places: 1}
+	if act := f.Format(decimal.RequireFromString("10000.5")); act != "1万0.5" {
+		t.Errorf("expect(%v) != actual(%v)", "1万0.5", act)
+	}
+}
+
+func TestFormatterNull(t *testing.T) {
+	f := decimal.Formatter{Group: ",", Null: "-"}
+	if act := f.FormatNull(decimal.Null); act != "-" {
+		t.Errorf("expect(%v) != actual(%v)", "-", act)
+	}
+	if act := decimal.NewFromInt(1000).Nullable().FormatWith(f); act != "1,000" {
+		t.Errorf("expect(%v) != actual(%v)", "1,000", act)
+	}
+	if act := decimal.Null.FormatWith(decimal.Formatter{}); act != "" {
+		t.Errorf("expect(%v) != actual(%v)", "", act)
+	}
+}
+
+func TestFormatVerb(t *testing.T) {
+	d := decimal.RequireFromString("-1234567.891")
+	for _, tc := range []struct {
+		format string
+		v      interface{}
+		expect string
+	}{
+		{"%v", d, "-1234567.891"},
+		{"%s", d, "-1234567.891"},
+		{"%f", d, "-1234567.891"},
+		{"%.2f", d, "-1234567.89"},
+		{"%n", d, "-1,234,567.891"},
+		{"%.0n", d, "-1,234,568"},
+		{"%#.0n", d, "-123万4,568"},
+		{"%10.1f", decimal.RequireFromString("3.14"), "       3.1"},
+		{"%-6n|", decimal.NewFromInt(1234), "1,234 |"},
+		{"%.1n", decimal.NewFromInt(1234).Nullable(), "1,234.0"},
+		{"%n", decimal.Null, ""},
+		{"%.2f", decimal.Null, ""},
+		{"%v", decimal.Null, ""},
+	} {
+		if act := fmt.Sprintf(tc.format, tc.v); act != tc.expect {
+			t.Errorf("%s: expect(%v) != actual(%v)", tc.format, tc.expect, act)
+		}
+	}
+}
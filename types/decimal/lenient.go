@@ -0,0 +1,239 @@
+package decimal
+
+import (
+	"database/sql"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/MineTakaki/go-utils/errors"
+	"github.com/MineTakaki/go-utils/internal/conv"
+)
+
+// LenientParser parses formatted numeric strings taken from spreadsheets and
+// printouts, such as "1,234", "¥1,000", "△500", "(1,200)" and "１２３．４".
+//
+// It accepts:
+//
+//	full-width digits, signs and punctuation
+//	grouping commas in the integer part, in groups of three digits ("1,234,567")
+//	currency marks ("¥", "￥", "\", "$", "€", "£") and the suffix "円"
+//	one sign: a leading "+", "-", "△" or "▲", a trailing "-", or enclosing parentheses for negatives
+//
+// Percent and kanji multipliers are applied only when enabled.
+// Decimal.Scan and NullDecimal.Scan stay strict; use ScanWith, or Scanner with
+// database/sql, to scan with a parser.
+type LenientParser struct {
+	Percent bool // "12%" is parsed as 0.12
+	Kanji   bool // kanji magnitude units are applied: "1.2万" is parsed as 12000, "1億2,345万" as 123450000
+}
+
+// ParseLenient parses s with all the options of LenientParser enabled.
+func ParseLenient(s string) (Decimal, error) {
+	return LenientParser{Percent: true, Kanji: true}.Parse(s)
+}
+
+// ScanWith is like Scan, but strings that ValueOf rejects are parsed with p.
+func (d *Decimal) ScanWith(p LenientParser, value interface{}) error {
+	if x, ok := ValueOf(value); ok {
+		*d = x
+		return nil
+	}
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return errors.Wrapf(ErrScan, "scan value error: %v", value)
+	}
+	x, err := p.Parse(s)
+	if err != nil {
+		return err
+	}
+	*d = x
+	return nil
+}
+
+// ScanWith is like Scan, but strings that ValueOf rejects are parsed with p.
+func (d *NullDecimal) ScanWith(p LenientParser, value interface{}) error {
+	if conv.IsEmpty(value) {
+		d.Decimal = Zero
+		d.Valid = false
+		return nil
+	}
+	if err := d.Decimal.ScanWith(p, value); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
+
+// lenientScanner scans into a NullDecimal with a LenientParser.
+type lenientScanner struct {
+	p LenientParser
+	d *NullDecimal
+}
+
+func (s lenientScanner) Scan(value interface{}) error {
+	return s.d.ScanWith(s.p, value)
+}
+
+// Scanner returns an sql.Scanner that scans into d like NullDecimal.Scan,
+// parsing strings that ValueOf rejects with p.
+//
+// Example:
+//
+//	var amount NullDecimal
+//	err := rows.Scan(&name, LenientParser{}.Scanner(&amount)) // "△1,234" is scanned as -1234
+func (p LenientParser) Scanner(d *NullDecimal) sql.Scanner {
+	return lenientScanner{p: p, d: d}
+}
+
+// kanjiMultipliers are the exponents of the kanji magnitude units.
+var kanjiMultipliers = map[rune]int32{'万': 4, '億': 8, '兆': 12, '京': 16, '垓': 20}
+
+// normalizeNumber converts full-width characters to ASCII and removes spaces.
+func normalizeNumber(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '！' && r <= '～':
+			b.WriteRune(r - '！' + '!')
+		case r == '−' || r == '‐' || r == '―':
+			b.WriteByte('-')
+		case r == '￥':
+			b.WriteRune('¥')
+		case r == ' ' || r == '\t' || r == '　':
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Parse parses s as a decimal.
+func (p LenientParser) Parse(s string) (Decimal, error) {
+	src := s
+	s = normalizeNumber(s)
+
+	neg, plus := 0, 0
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		s = s[1 : len(s)-1]
+		neg++
+	}
+	percent := false
+	for s != "" {
+		r, n := utf8.DecodeRuneInString(s)
+		switch r {
+		case '-', '△', '▲':
+			neg++
+		case '+':
+			plus++
+		case '¥', '\\', '$', '€', '£':
+		default:
+			n = 0
+		}
+		if n == 0 {
+			break
+		}
+		s = s[n:]
+	}
+	for s != "" {
+		r, n := utf8.DecodeLastRuneInString(s)
+		switch r {
+		case '-':
+			neg++
+		case '円':
+		case '%':
+			if percent || !p.Percent {
+				return Zero, errors.Wrapf(ErrScan, "'%s'", src)
+			}
+			percent = true
+		default:
+			n = 0
+		}
+		if n == 0 {
+			break
+		}
+		s = s[:len(s)-n]
+	}
+	if neg+plus > 1 || s == "" {
+		return Zero, errors.Wrapf(ErrScan, "'%s'", src)
+	}
+
+	var d Decimal
+	var ok bool
+	if p.Kanji {
+		d, ok = parseKanjiNumber(s)
+	} else {
+		d, ok = parseGrouped(s)
+	}
+	if !ok {
+		return Zero, errors.Wrapf(ErrScan, "'%s'", src)
+	}
+	if percent {
+		d = d.Shift(-2)
+	}
+	if neg != 0 {
+		d = d.Neg()
+	}
+	return d, nil
+}
+
+// parseGrouped parses an unsigned number with grouping commas in the integer part.
+// The groups after the first comma must have three digits each ("1,234,567").
+func parseGrouped(s string) (Decimal, bool) {
+	num, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		num, frac = s[:i], s[i:]
+	}
+	if strings.Contains(frac, ",") {
+		return Zero, false
+	}
+	if groups := strings.Split(num, ","); len(groups) > 1 {
+		if n := len(groups[0]); n == 0 || n > 3 {
+			return Zero, false
+		}
+		for _, g := range groups[1:] {
+			if len(g) != 3 {
+				return Zero, false
+			}
+		}
+		num = strings.Join(groups, "")
+	}
+	for _, c := range num + strings.TrimPrefix(frac, ".") {
+		if c < '0' || c > '9' {
+			return Zero, false
+		}
+	}
+	d, err := NewFromString(num + frac)
+	return d, err == nil
+}
+
+// parseKanjiNumber parses an unsigned number with kanji magnitude units in
+// descending order ("1億2,345万6,789", "1.2万").
+func parseKanjiNumber(s string) (Decimal, bool) {
+	sum := Zero
+	last := int32(-1)
+	for s != "" {
+		i := strings.IndexFunc(s, func(r rune) bool { _, ok := kanjiMultipliers[r]; return ok })
+		if i < 0 {
+			d, ok := parseGrouped(s)
+			return sum.Add(d), ok
+		}
+		r, n := utf8.DecodeRuneInString(s[i:])
+		e := kanjiMultipliers[r]
+		if last >= 0 && e >= last {
+			return Zero, false
+		}
+		d, ok := parseGrouped(s[:i])
+		if !ok {
+			return Zero, false
+		}
+		sum = sum.Add(d.Shift(e))
+		last = e
+		s = s[i+n:]
+	}
+	return sum, true
+}
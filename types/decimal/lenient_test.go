@@ -0,0 +1,154 @@
+package decimal_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/MineTakaki/go-utils/errors"
+	"github.com/MineTakaki/go-utils/types/decimal"
+)
+
+func TestLenientParser(t *testing.T) {
+	plain := decimal.LenientParser{}
+	all := decimal.LenientParser{Percent: true, Kanji: true}
+	for _, tc := range []struct {
+		p      decimal.LenientParser
+		src    string
+		expect string
+		err    bool
+	}{
+		{plain, "1,234", "1234", false},
+		{plain, "1,234,567.89", "1234567.89", false},
+		{plain, " 1,234 ", "1234", false},
+		{plain, "¥1,000", "1000", false},
+		{plain, "￥1,000", "1000", false},
+		{plain, "$12.50", "12.5", false},
+		{plain, "1,000円", "1000", false},
+		{plain, "△500", "-500", false},
+		{plain, "▲500", "-500", false},
+		{plain, "(1,200)", "-1200", false},
+		{plain, "(¥1,200)", "-1200", false},
+		{plain, "-¥1,000", "-1000", false},
+		{plain, "¥-1,000", "-1000", false},
+		{plain, "1,000-", "-1000", false},
+		{plain, "+12", "12", false},
+		{plain, "１２３．４", "123.4", false},
+		{plain, "－１，２３４", "-1234", false},
+		{plain, "△　５００円", "-500", false},
+		{plain, "12%", "", true},
+		{plain, "1.2万", "", true},
+		{plain, "-(100)", "", true},
+		{plain, "△-100", "", true},
+		{plain, "+-1", "", true},
+		{plain, "-+1", "", true},
+		{plain, "+1-", "", true},
+		{plain, "(+1)", "", true},
+		{plain, "++1", "", true},
+		{plain, "1.2.3", "", true},
+		{plain, "1.2,3", "", true},
+		{plain, ",123", "", true},
+		{plain, "123,", "", true},
+		{plain, "1,23", "", true},
+		{plain, "12,34,567", "", true},
+		{plain, "1234,567", "", true},
+		{plain, "1,2345", "", true},
+		{plain, "1,,234", "", true},
+		{plain, "abc", "", true},
+		{plain, "¥", "", true},
+		{plain, "", "", true},
+		{all, "12%", "0.12", false},
+		{all, "１２．５％", "0.125", false},
+		{all, "△5%", "-0.05", false},
+		{all, "12%%", "", true},
+		{all, "1.2万", "12000", false},
+		{all, "1億2,345万円", "123450000", false},
+		{all, "1億23万4,567", "100234567", false},
+		{all, "△3万", "-30000", false},
+		{all, "1兆", "1000000000000", false},
+		{all, "1万2億", "", true},
+		{all, "1万万", "", true},
+		{all, "1万2,34", "", true},
+		{all, "x万", "", true},
+	} {
+		d, err := tc.p.Parse(tc.src)
+		if tc.err {
+			if !errors.Is(err, decimal.ErrScan) {
+				t.Errorf("%+v '%s': expect ErrScan, actual %v (%v)", tc.p, tc.src, err, d)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%+v '%s': %+v", tc.p, tc.src, err)
+		} else if d.String() != tc.expect {
+			t.Errorf("%+v '%s': expect(%v) != actual(%v)", tc.p, tc.src, tc.expect, d)
+		}
+	}
+}
+
+func TestParseLenient(t *testing.T) {
+	f := decimal.Formatter{Group: ",", Kanji: true, Suffix: "円", Sign: decimal.SignTriangle}
+	for _, src := range []string{"123450000", "-1234567890123", "0", "10000"} {
+		d := decimal.RequireFromString(src)
+		s := f.Format(d)
+		if act, err := decimal.ParseLenient(s); err != nil {
+			t.Errorf("%s: %+v", s, err)
+		} else if !act.Equal(d) {
+			t.Errorf("%s: expect(%v) != actual(%v)", s, d, act)
+		}
+	}
+}
+
+func TestScanLenient(t *testing.T) {
+	p := decimal.LenientParser{}
+	var d decimal.Decimal
+	if err := d.ScanWith(p, "△1,234"); err != nil {
+		t.Errorf("%+v", err)
+	} else if d.String() != "-1234" {
+		t.Errorf("expect(%v) != actual(%v)", "-1234", d)
+	}
+	if err := d.ScanWith(p, 12); err != nil || d.String() != "12" {
+		t.Errorf("expect(%v) != actual(%v) %v", "12", d, err)
+	}
+
+	var n decimal.NullDecimal
+	if err := n.ScanWith(p, []byte("(1,200)")); err != nil {
+		t.Errorf("%+v", err)
+	} else if !n.Valid || n.String() != "-1200" {
+		t.Errorf("expect(%v) != actual(%v)", "-1200", n)
+	}
+	if err := n.ScanWith(p, ""); err != nil || n.Valid {
+		t.Errorf("expect(NULL) != actual(%v) %v", n, err)
+	}
+	if err := n.ScanWith(p, "12%"); !errors.Is(err, decimal.ErrScan) {
+		t.Errorf("12%%: expect ErrScan, actual %v", err)
+	}
+	if err := n.ScanWith(decimal.LenientParser{Percent: true}, "12%"); err != nil {
+		t.Errorf("%+v", err)
+	} else if n.String() != "0.12" {
+		t.Errorf("expect(%v) != actual(%v)", "0.12", n)
+	}
+
+	// database/sql の Scan と同じく sql.Scanner として読み取ります
+	var sc sql.Scanner = decimal.LenientParser{Kanji: true}.Scanner(&n)
+	if err := sc.Scan([]byte("△1.2万")); err != nil {
+		t.Errorf("%+v", err)
+	} else if !n.Valid || n.String() != "-12000" {
+		t.Errorf("expect(%v) != actual(%v)", "-12000", n)
+	}
+	if err := sc.Scan(nil); err != nil || n.Valid {
+		t.Errorf("expect(NULL) != actual(%v) %v", n, err)
+	}
+	if err := sc.Scan("abc"); !errors.Is(err, decimal.ErrScan) {
+		t.Errorf("expect ErrScan, actual %v", err)
+	}
+
+	// Scan は寛容な読み取りを行いません
+	for _, v := range []string{"△1,234", "(5)", "5-", "$-5", "+-1"} {
+		if err := d.Scan(v); !errors.Is(err, decimal.ErrScan) {
+			t.Errorf("%s: expect ErrScan, actual %v (%v)", v, err, d)
+		}
+		if err := n.Scan(v); !errors.Is(err, decimal.ErrScan) {
+			t.Errorf("%s: expect ErrScan, actual %v (%v)", v, err, n)
+		}
+	}
+}
@@ -37,6 +37,11 @@ func (d NullDecimal) String() string {
 	return d.Decimal.String()
 }
 
+// Nullable NullDecimal型を返します（Decimal型と同じ操作でNullDecimal型を取得するためのメソッドです）
+func (d NullDecimal) Nullable() NullDecimal {
+	return d
+}
+
 // Ptr Decimal型のポインタを返します。Valid=falseの時はnilを返します
 func (d NullDecimal) Ptr() *Decimal {
 	if !d.Valid {
@@ -101,7 +106,9 @@ func (d NullDecimal) LessThanNZ(o NullDecimal) bool {
 	return o.Valid && Zero.LessThan(o.Decimal)
 }
 
-// Format fmt.Formatterインターフェイスの実装
+// Format fmt.Formatterインターフェイスの実装（NULLの場合は何も出力しません）
+//
+//	Decimal型と同じく %f, %n を使用できます
 func (d NullDecimal) Format(s fmt.State, verb rune) {
 	switch verb {
 	case 'v':
@@ -117,6 +124,10 @@ func (d NullDecimal) Format(s fmt.State, verb rune) {
 		} else {
 			io.WriteString(s, "")
 		}
+	case 'f', 'F', 'n':
+		if d.Valid {
+			formatVerb(s, verb, d.Decimal)
+		}
 	}
 }
 
@@ -145,7 +156,8 @@ func (d *NullDecimal) Scan(value interface{}) error {
 	return d.Decimal.Scan(value)
 }
 
-// MarshalLogObject implements of zapcore.ObjectMarshaler interface.
+// MarshalLogObject implements of zapcore.ObjectMarshaler interface ({"decimal":"12.5","valid":true}).
+// Use zapx.NullDecimal for the plain string form that LogValue writes.
 func (d NullDecimal) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("decimal", d.Decimal.String())
 	enc.AddBool("valid", d.Valid)
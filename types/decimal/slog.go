@@ -0,0 +1,18 @@
+//go:build go1.21
+
+package decimal
+
+import "log/slog"
+
+// LogValue implements of slog.LogValuer interface.
+func (d Decimal) LogValue() slog.Value {
+	return slog.StringValue(d.String())
+}
+
+// LogValue implements of slog.LogValuer interface. NULL is rendered as nil.
+func (d NullDecimal) LogValue() slog.Value {
+	if !d.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(d.Decimal.String())
+}
@@ -15,24 +15,138 @@ var nullDecimalType = reflect.TypeOf((*NullDecimal)(nil)).Elem()
 var decimalType2 = reflect.TypeOf((*decimal.Decimal)(nil)).Elem()
 var nullDecimalType2 = reflect.TypeOf((*decimal.NullDecimal)(nil)).Elem()
 
-//SumN 集計します。エラーの場合はNullとしてあつかいます
-func SumN(arr ...interface{}) (sum NullDecimal) {
-	return
+// NullPolicy SumN・MulN・MaxN・MinN 等の集計でのNULLの扱い
+type NullPolicy int
+
+const (
+	// NullSkip NULLを除いて集計します（すべてNULLの場合はNULL）
+	NullSkip NullPolicy = iota
+	// NullPropagate 1つでもNULLがある場合はNULLになります
+	NullPropagate
+)
+
+// Decimals Decimal型・NullDecimal型の制約
+type Decimals interface {
+	Decimal | NullDecimal
+	Nullable() NullDecimal
+}
+
+// accumulator NullPolicyに従って値を集計します
+type accumulator struct {
+	policy NullPolicy
+	op     func(a, b Decimal) Decimal
+	r      NullDecimal
+	null   bool
+}
+
+func (a *accumulator) add(d Decimal, valid bool) {
+	switch {
+	case a.null:
+	case !valid:
+		a.null = a.policy == NullPropagate
+	case !a.r.Valid:
+		a.r = NullDecimal{Decimal: d, Valid: true}
+	default:
+		a.r.Decimal = a.op(a.r.Decimal, d)
+	}
+}
+
+func (a *accumulator) result() NullDecimal {
+	if a.null {
+		return Null
+	}
+	return a.r
+}
+
+func maxOp(a, b Decimal) Decimal {
+	if b.GreaterThan(a) {
+		return b
+	}
+	return a
+}
+
+func minOp(a, b Decimal) Decimal {
+	if b.LessThan(a) {
+		return b
+	}
+	return a
+}
+
+func (p NullPolicy) aggregate(op func(a, b Decimal) Decimal, arr []interface{}) NullDecimal {
+	a := accumulator{policy: p, op: op}
+	for _, v := range arr {
+		a.add(ValueOf(v))
+	}
+	return a.result()
+}
+
+func aggregateOf[T Decimals](p NullPolicy, op func(a, b Decimal) Decimal, v []T) NullDecimal {
+	a := accumulator{policy: p, op: op}
+	for _, x := range v {
+		n := x.Nullable()
+		a.add(n.Decimal, n.Valid)
+	}
+	return a.result()
+}
+
+// SumN 合計を返します。値はValueOfで変換し、NULLと変換できない値は除きます（すべてNULLの場合はNULL）
+func SumN(arr ...interface{}) NullDecimal {
+	return NullSkip.SumN(arr...)
+}
+
+// MulN 積を返します。値はValueOfで変換し、NULLと変換できない値は除きます（すべてNULLの場合はNULL）
+func MulN(arr ...interface{}) NullDecimal {
+	return NullSkip.MulN(arr...)
+}
+
+// MaxN NULLを除く最も大きな値を返します。値はValueOfで変換し、変換できない値はNULLとしてあつかいます
+func MaxN(arr ...interface{}) NullDecimal {
+	return NullSkip.MaxN(arr...)
+}
+
+// MinN NULLを除く最も小さな値を返します。値はValueOfで変換し、変換できない値はNULLとしてあつかいます
+func MinN(arr ...interface{}) NullDecimal {
+	return NullSkip.MinN(arr...)
+}
+
+// SumN NULLの扱いを指定して合計を返します
+func (p NullPolicy) SumN(arr ...interface{}) NullDecimal {
+	return p.aggregate(Decimal.Add, arr)
+}
+
+// MulN NULLの扱いを指定して積を返します
+func (p NullPolicy) MulN(arr ...interface{}) NullDecimal {
+	return p.aggregate(Decimal.Mul, arr)
+}
+
+// MaxN NULLの扱いを指定して最も大きな値を返します
+func (p NullPolicy) MaxN(arr ...interface{}) NullDecimal {
+	return p.aggregate(maxOp, arr)
+}
+
+// MinN NULLの扱いを指定して最も小さな値を返します
+func (p NullPolicy) MinN(arr ...interface{}) NullDecimal {
+	return p.aggregate(minOp, arr)
+}
+
+// SumOf []Decimal・[]NullDecimal の合計を返します（interface{}への変換を行いません）
+func SumOf[T Decimals](p NullPolicy, v []T) NullDecimal {
+	return aggregateOf(p, Decimal.Add, v)
 }
 
-//MulN NullDecimal同士で乗算します。エラーの場合はNullとしてあつかいます
-func MulN(arr ...interface{}) (m NullDecimal) {
-	return
+// MulOf []Decimal・[]NullDecimal の積を返します（interface{}への変換を行いません）
+func MulOf[T Decimals](p NullPolicy, v []T) NullDecimal {
+	return aggregateOf(p, Decimal.Mul, v)
 }
 
-//MaxN Nullを除く最も大きな値を返します
-func MaxN(arr ...interface{}) (max NullDecimal) {
-	return
+// MaxOf []Decimal・[]NullDecimal の最も大きな値を返します（interface{}への変換を行いません）
+func MaxOf[T Decimals](p NullPolicy, v []T) NullDecimal {
+	return aggregateOf(p, maxOp, v)
 }
 
-//MinN Nullを除く最も小さな値を返します
-func MinN(arr ...interface{}) (min NullDecimal) {
-	return
+// MinOf []Decimal・[]NullDecimal の最も小さな値を返します（interface{}への変換を行いません）
+func MinOf[T Decimals](p NullPolicy, v []T) NullDecimal {
+	return aggregateOf(p, minOp, v)
 }
 
 func unquoteIfQuoted(arr []byte) string {
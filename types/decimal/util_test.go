@@ -44,3 +44,63 @@ func TestValueOf(t *testing.T) {
 		t.Errorf("[Null] value must be Zero: %v", d)
 	}
 }
+
+func TestAggregateN(t *testing.T) {
+	d := decimal.RequireFromString
+	for _, tc := range []struct {
+		name   string
+		fn     func(...interface{}) decimal.NullDecimal
+		arr    []interface{}
+		expect string
+	}{
+		{"SumN", decimal.SumN, []interface{}{1, 2.5, "3", d("0.5"), d("1").Nullable(), uint8(2)}, "10"},
+		{"SumN null", decimal.SumN, []interface{}{1, nil, decimal.Null, sql.NullInt64{}, "abc", 2}, "3"},
+		{"SumN empty", decimal.SumN, nil, ""},
+		{"SumN all null", decimal.SumN, []interface{}{nil, decimal.Null}, ""},
+		{"MulN", decimal.MulN, []interface{}{2, "1.5", d("4"), nil}, "12"},
+		{"MaxN", decimal.MaxN, []interface{}{3, "-1", nil, 7.5, sql.NullInt64{Int64: 5, Valid: true}}, "7.5"},
+		{"MinN", decimal.MinN, []interface{}{3, "-1", nil, 7.5}, "-1"},
+		{"MaxN all null", decimal.MaxN, []interface{}{decimal.Null}, ""},
+		{"Propagate SumN", decimal.NullPropagate.SumN, []interface{}{1, 2, 3}, "6"},
+		{"Propagate SumN null", decimal.NullPropagate.SumN, []interface{}{1, nil, 3}, ""},
+		{"Propagate MulN null", decimal.NullPropagate.MulN, []interface{}{2, decimal.Null}, ""},
+		{"Propagate MaxN error", decimal.NullPropagate.MaxN, []interface{}{2, "abc"}, ""},
+		{"Propagate MinN", decimal.NullPropagate.MinN, []interface{}{2, "-3"}, "-3"},
+		{"Skip MinN", decimal.NullSkip.MinN, []interface{}{2, nil, "-3"}, "-3"},
+	} {
+		if act := tc.fn(tc.arr...).String(); act != tc.expect {
+			t.Errorf("%s: expect(%v) != actual(%v)", tc.name, tc.expect, act)
+		}
+	}
+}
+
+func TestAggregateOf(t *testing.T) {
+	d := decimal.RequireFromString
+	v := []decimal.Decimal{d("3"), d("-2"), d("5")}
+	nv := []decimal.NullDecimal{d("3").Nullable(), decimal.Null, d("-2").Nullable()}
+	for _, tc := range []struct {
+		name   string
+		act    decimal.NullDecimal
+		expect string
+	}{
+		{"SumOf", decimal.SumOf(decimal.NullSkip, v), "6"},
+		{"MulOf", decimal.MulOf(decimal.NullSkip, v), "-30"},
+		{"MaxOf", decimal.MaxOf(decimal.NullSkip, v), "5"},
+		{"MinOf", decimal.MinOf(decimal.NullPropagate, v), "-2"},
+		{"SumOf empty", decimal.SumOf(decimal.NullSkip, []decimal.Decimal{}), ""},
+		{"SumOf null", decimal.SumOf(decimal.NullSkip, nv), "1"},
+		{"MulOf null", decimal.MulOf(decimal.NullSkip, nv), "-6"},
+		{"MaxOf null", decimal.MaxOf(decimal.NullSkip, nv), "3"},
+		{"MinOf null", decimal.MinOf(decimal.NullSkip, nv), "-2"},
+		{"SumOf propagate", decimal.SumOf(decimal.NullPropagate, nv), ""},
+		{"MaxOf propagate", decimal.MaxOf(decimal.NullPropagate, nv), ""},
+	} {
+		if act := tc.act.String(); act != tc.expect {
+			t.Errorf("%s: expect(%v) != actual(%v)", tc.name, tc.expect, act)
+		}
+	}
+
+	if n := testing.AllocsPerRun(100, func() { decimal.MaxOf(decimal.NullSkip, nv) }); n != 0 {
+		t.Errorf("MaxOf allocs: expect(%v) != actual(%v)", 0, n)
+	}
+}
@@ -0,0 +1,124 @@
+package types
+
+import "time"
+
+// DiffDays oからymdまでの日数（ymd - o）を取得します。いずれかが0の場合は0を返します
+func (ymd Ymd) DiffDays(o Ymd) int {
+	if ymd == 0 || o == 0 {
+		return 0
+	}
+	return ymd.Days() - o.Days()
+}
+
+// DiffMonths oからymdまでの経過月数を取得します（1か月に満たない端数は切り捨てます）
+//
+//	月の加算と同じく、日が月末を超える場合は月末日で1か月経過とします（1/31 → 2/29 は1か月）。
+//	ymd < o の場合は入れ替えて計算したマイナス値になります
+func (ymd Ymd) DiffMonths(o Ymd) int {
+	if ymd == 0 || o == 0 {
+		return 0
+	}
+	if ymd < o {
+		return -o.DiffMonths(ymd)
+	}
+	n := ymd.DiffCalendarMonths(o)
+	if n > 0 && o.Add(0, n, 0) > ymd {
+		n--
+	}
+	return n
+}
+
+// DiffYears oからymdまでの経過年数を取得します（1年に満たない端数は切り捨てます）
+//
+//	2/29からの1年は翌年の2/28で経過とします
+func (ymd Ymd) DiffYears(o Ymd) int {
+	return ymd.DiffMonths(o) / 12
+}
+
+// DiffCalendarMonths oからymdまでの暦上の月数（年月の差）を取得します。日は考慮しません（1/31 → 2/1 は1か月）
+func (ymd Ymd) DiffCalendarMonths(o Ymd) int {
+	if ymd == 0 || o == 0 {
+		return 0
+	}
+	return o.YearMonth().MonthsUntil(ymd.YearMonth())
+}
+
+// DiffCalendarYears oからymdまでの暦上の年数（年の差）を取得します。月日は考慮しません（12/31 → 1/1 は1年）
+func (ymd Ymd) DiffCalendarYears(o Ymd) int {
+	if ymd == 0 || o == 0 {
+		return 0
+	}
+	return ymd.Year() - o.Year()
+}
+
+// Age ymdを生年月日として、at日時点の満年齢を取得します
+//
+//	年齢計算ニ関スル法律に従い、誕生日の前日の終了時（24時）に年齢が加算されるため、
+//	at日には誕生日の当日から新しい年齢になります。2/29生まれの場合、平年は2/28の終了時（3/1）に加算します。
+//	at < ymd の場合は0を返します
+func (ymd Ymd) Age(at Ymd) int {
+	if ymd == 0 || at == 0 || at < ymd {
+		return 0
+	}
+	by, bm, bd := ymd.Part()
+	y, m, d := at.Part()
+	n := y - by
+	if m < bm || (m == bm && d < bd) {
+		n--
+	}
+	return n
+}
+
+// AgeReachedOn ymdを生年月日として、ageで指定した年齢に達する日（誕生日の前日）を取得します
+//
+//	年齢計算ニ関スル法律により、その日の終了時に年齢に達します。
+//	学年の区切り（4/1生まれは3/31に達するため前の学年）等の判定に使用します
+func (ymd Ymd) AgeReachedOn(age int) Ymd {
+	if ymd == 0 {
+		return 0
+	}
+	by, bm, bd := ymd.Part()
+	y := by + age
+	if bm == 2 && bd == 29 && !IsLeapYear(y) {
+		// 平年は3/1を誕生日として扱います
+		return Ymd(y*10000 + 301).Prev()
+	}
+	return Ymd(y*10000 + bm*100 + bd).Prev()
+}
+
+// MonthsUntil ymからoまでの月数（o - ym）を取得します。いずれかが0の場合は0を返します
+func (ym Ym) MonthsUntil(o Ym) int {
+	if ym == 0 || o == 0 {
+		return 0
+	}
+	y1, m1 := ym.Part()
+	y2, m2 := o.Part()
+	return (y2*12 + m2) - (y1*12 + m1)
+}
+
+// SubSeconds oからyhまでの秒数（yh - o）を取得します。いずれかが0の場合は0を返します
+//
+//	タイムゾーンや夏時間は考慮しません
+func (yh Ymdhms) SubSeconds(o Ymdhms) int64 {
+	if yh == 0 || o == 0 {
+		return 0
+	}
+	return int64(yh.Ymd().DiffDays(o.Ymd()))*secondsPerDay + int64(yh.Hms().Seconds()-o.Hms().Seconds())
+}
+
+// Sub oからyhまでの経過時間（yh - o）を取得します
+//
+//	タイムゾーンや夏時間は考慮しません。time.Durationの範囲（約292年）を超える場合は正しい値になりません
+func (yh Ymdhms) Sub(o Ymdhms) time.Duration {
+	return time.Duration(yh.SubSeconds(o)) * time.Second
+}
+
+// Sub oからxまでの経過時間（x - o）を取得します
+//
+//	タイムゾーンや夏時間は考慮しません
+func (x YmdhmsMs) Sub(o YmdhmsMs) time.Duration {
+	if x == 0 || o == 0 {
+		return 0
+	}
+	return x.Ymdhms().Sub(o.Ymdhms()) + time.Duration(x.Millisecond()-o.Millisecond())*time.Millisecond
+}
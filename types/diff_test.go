@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestYmdDiff(t *testing.T) {
+	for _, x := range []struct {
+		ymd, o                      Ymd
+		days, months, years, cm, cy int
+	}{
+		{20240229, 20240131, 29, 1, 0, 1, 0},
+		{20240228, 20240131, 28, 0, 0, 1, 0},
+		{20240201, 20240131, 1, 0, 0, 1, 0},
+		{20250228, 20240229, 365, 12, 1, 12, 1},
+		{20250227, 20240229, 364, 11, 0, 12, 1},
+		{20240101, 20231231, 1, 0, 0, 1, 1},
+		{20240131, 20240229, -29, -1, 0, -1, 0},
+		{20230315, 20240316, -367, -12, -1, -12, -1},
+		{20240101, 0, 0, 0, 0, 0, 0},
+	} {
+		if act := x.ymd.DiffDays(x.o); act != x.days {
+			t.Errorf("%v-%v DiffDays: expect(%v) != actual(%v)", x.ymd, x.o, x.days, act)
+		}
+		if act := x.ymd.DiffMonths(x.o); act != x.months {
+			t.Errorf("%v-%v DiffMonths: expect(%v) != actual(%v)", x.ymd, x.o, x.months, act)
+		}
+		if act := x.ymd.DiffYears(x.o); act != x.years {
+			t.Errorf("%v-%v DiffYears: expect(%v) != actual(%v)", x.ymd, x.o, x.years, act)
+		}
+		if act := x.ymd.DiffCalendarMonths(x.o); act != x.cm {
+			t.Errorf("%v-%v DiffCalendarMonths: expect(%v) != actual(%v)", x.ymd, x.o, x.cm, act)
+		}
+		if act := x.ymd.DiffCalendarYears(x.o); act != x.cy {
+			t.Errorf("%v-%v DiffCalendarYears: expect(%v) != actual(%v)", x.ymd, x.o, x.cy, act)
+		}
+	}
+}
+
+func TestYmdAge(t *testing.T) {
+	for _, x := range []struct {
+		birth, at Ymd
+		exp       int
+	}{
+		{20000401, 20240331, 23},
+		{20000401, 20240401, 24},
+		{20000229, 20230228, 22},
+		{20000229, 20230301, 23},
+		{20000229, 20240229, 24},
+		{20000101, 19991231, 0},
+	} {
+		if act := x.birth.Age(x.at); act != x.exp {
+			t.Errorf("%v at %v: expect(%v) != actual(%v)", x.birth, x.at, x.exp, act)
+		}
+	}
+
+	for _, x := range []struct {
+		birth Ymd
+		age   int
+		exp   Ymd
+	}{
+		{20000401, 6, 20060331},
+		{20000229, 3, 20030228},
+		{20000229, 4, 20040228},
+		{20010301, 3, 20040229},
+	} {
+		if act := x.birth.AgeReachedOn(x.age); act != x.exp {
+			t.Errorf("%v age %d: expect(%v) != actual(%v)", x.birth, x.age, x.exp, act)
+		}
+	}
+}
+
+func TestYmMonthsUntil(t *testing.T) {
+	if act := Ym(202311).MonthsUntil(202402); act != 3 {
+		t.Errorf("expect(%v) != actual(%v)", 3, act)
+	}
+	if act := Ym(202402).MonthsUntil(202311); act != -3 {
+		t.Errorf("expect(%v) != actual(%v)", -3, act)
+	}
+}
+
+func TestYmdhmsSub(t *testing.T) {
+	if act := types.Ymdhms(20240301003000).Sub(20240228233000); act != 25*time.Hour {
+		t.Errorf("expect(%v) != actual(%v)", 25*time.Hour, act)
+	}
+	if act := types.Ymdhms(20240101000000).SubSeconds(20240101000001); act != -1 {
+		t.Errorf("expect(%v) != actual(%v)", -1, act)
+	}
+	if act := types.YmdhmsMs(20240101000000100).Sub(20231231235959900); act != 200*time.Millisecond {
+		t.Errorf("expect(%v) != actual(%v)", 200*time.Millisecond, act)
+	}
+}
@@ -0,0 +1,302 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+
+	"github.com/MineTakaki/go-utils/errors"
+)
+
+// JSONFormat 日付型をJSONに変換する際の表現
+type JSONFormat int
+
+const (
+	// JSONDefault 型ごとの従来の表現（Ymd, Ym, Md, Hms, Ywは数値、Ymdhms, YmdhmsMsは文字列）
+	JSONDefault JSONFormat = iota
+	// JSONNumber 数値（20240305）
+	JSONNumber
+	// JSONString 文字列（"20240305"）
+	JSONString
+	// JSONISO8601 ISO-8601形式の文字列（"2024-03-05", "2024-03-05T10:30:15" 等）
+	JSONISO8601
+)
+
+var _jsonFormatMu sync.RWMutex
+var _jsonFormat = JSONDefault
+
+// DefaultJSONFormat 日付型のJSONの表現を取得します
+func DefaultJSONFormat() JSONFormat {
+	_jsonFormatMu.RLock()
+	defer _jsonFormatMu.RUnlock()
+	return _jsonFormat
+}
+
+// SetJSONFormat 日付型のJSONの表現を設定します
+//
+//	UnmarshalJSONはいずれの表現も読み取ることができます
+func SetJSONFormat(f JSONFormat) {
+	_jsonFormatMu.Lock()
+	_jsonFormat = f
+	_jsonFormatMu.Unlock()
+}
+
+func marshalJSON[T Temporal[T]](v T, def JSONFormat, iso func() string) ([]byte, error) {
+	f := DefaultJSONFormat()
+	if f == JSONDefault {
+		f = def
+	}
+	switch f {
+	case JSONString:
+		return json.Marshal(v.String())
+	case JSONISO8601:
+		return json.Marshal(iso())
+	}
+	return json.Marshal(int64(v))
+}
+
+func marshalBinary[T Temporal[T]](v T) ([]byte, error) {
+	b := make([]byte, binary.MaxVarintLen64)
+	return b[:binary.PutVarint(b, int64(v))], nil
+}
+
+func unmarshalBinary(b []byte) (int64, error) {
+	n, size := binary.Varint(b)
+	if size <= 0 || size != len(b) {
+		return 0, errors.Wrapf(ErrValidate, "incorrect binary data : %x", b)
+	}
+	return n, nil
+}
+
+// ISOString ISO-8601の形式（2024-03-05）でstring型に変換します
+func (ymd Ymd) ISOString() string {
+	return ymd.Format("yyyy-MM-dd")
+}
+
+// ISOString ISO-8601の形式（2024-03）でstring型に変換します
+func (ym Ym) ISOString() string {
+	return ym.Format("yyyy-MM")
+}
+
+// ISOString ISO-8601の形式（--03-05）でstring型に変換します
+func (md Md) ISOString() string {
+	return md.Format("--MM-dd")
+}
+
+// ISOString ISO-8601の形式（10:30:15）でstring型に変換します
+func (hms Hms) ISOString() string {
+	return hms.Format("HH:mm:ss")
+}
+
+// ISOString ISO-8601の形式（2024-03-05T10:30:15）でstring型に変換します
+func (yh Ymdhms) ISOString() string {
+	return yh.Format("yyyy-MM-dd'T'HH:mm:ss")
+}
+
+// ISOString ISO-8601の形式（2024-03-05T10:30:15.123）でstring型に変換します
+func (x YmdhmsMs) ISOString() string {
+	return x.Format("yyyy-MM-dd'T'HH:mm:ss.SSS")
+}
+
+// MarshalText encoding.TextMarshalerの実装
+func (ymd Ymd) MarshalText() ([]byte, error) {
+	return []byte(ymd.String()), nil
+}
+
+// UnmarshalText encoding.TextUnmarshalerの実装
+func (ymd *Ymd) UnmarshalText(b []byte) (err error) {
+	var x Ymd
+	if x, err = ParseYmd(string(b)); err != nil {
+		return
+	}
+	*ymd = x
+	return
+}
+
+// MarshalBinary encoding.BinaryMarshalerの実装
+func (ymd Ymd) MarshalBinary() ([]byte, error) {
+	return marshalBinary(ymd)
+}
+
+// UnmarshalBinary encoding.BinaryUnmarshalerの実装
+func (ymd *Ymd) UnmarshalBinary(b []byte) error {
+	n, err := unmarshalBinary(b)
+	if err == nil {
+		*ymd = Ymd(n)
+	}
+	return err
+}
+
+// MarshalText encoding.TextMarshalerの実装
+func (ym Ym) MarshalText() ([]byte, error) {
+	return []byte(ym.String()), nil
+}
+
+// UnmarshalText encoding.TextUnmarshalerの実装
+func (ym *Ym) UnmarshalText(b []byte) (err error) {
+	var x Ym
+	if x, err = ParseYm(string(b)); err != nil {
+		return
+	}
+	*ym = x
+	return
+}
+
+// MarshalBinary encoding.BinaryMarshalerの実装
+func (ym Ym) MarshalBinary() ([]byte, error) {
+	return marshalBinary(ym)
+}
+
+// UnmarshalBinary encoding.BinaryUnmarshalerの実装
+func (ym *Ym) UnmarshalBinary(b []byte) error {
+	n, err := unmarshalBinary(b)
+	if err == nil {
+		*ym = Ym(n)
+	}
+	return err
+}
+
+// MarshalText encoding.TextMarshalerの実装
+func (md Md) MarshalText() ([]byte, error) {
+	return []byte(md.String()), nil
+}
+
+// UnmarshalText encoding.TextUnmarshalerの実装
+func (md *Md) UnmarshalText(b []byte) (err error) {
+	var x Md
+	if x, err = ParseMd(string(b)); err != nil {
+		return
+	}
+	*md = x
+	return
+}
+
+// MarshalBinary encoding.BinaryMarshalerの実装
+func (md Md) MarshalBinary() ([]byte, error) {
+	return marshalBinary(md)
+}
+
+// UnmarshalBinary encoding.BinaryUnmarshalerの実装
+func (md *Md) UnmarshalBinary(b []byte) error {
+	n, err := unmarshalBinary(b)
+	if err == nil {
+		*md = Md(n)
+	}
+	return err
+}
+
+// MarshalText encoding.TextMarshalerの実装
+func (hms Hms) MarshalText() ([]byte, error) {
+	return []byte(hms.String()), nil
+}
+
+// UnmarshalText encoding.TextUnmarshalerの実装
+func (hms *Hms) UnmarshalText(b []byte) (err error) {
+	var x Hms
+	if x, err = ParseHms(string(b)); err != nil {
+		return
+	}
+	*hms = x
+	return
+}
+
+// MarshalBinary encoding.BinaryMarshalerの実装
+func (hms Hms) MarshalBinary() ([]byte, error) {
+	return marshalBinary(hms)
+}
+
+// UnmarshalBinary encoding.BinaryUnmarshalerの実装
+func (hms *Hms) UnmarshalBinary(b []byte) error {
+	n, err := unmarshalBinary(b)
+	if err == nil {
+		*hms = Hms(n)
+	}
+	return err
+}
+
+// MarshalText encoding.TextMarshalerの実装
+func (yh Ymdhms) MarshalText() ([]byte, error) {
+	return []byte(yh.String()), nil
+}
+
+// UnmarshalText encoding.TextUnmarshalerの実装
+func (yh *Ymdhms) UnmarshalText(b []byte) (err error) {
+	var x Ymdhms
+	if x, err = ParseYmdhms(string(b)); err != nil {
+		return
+	}
+	*yh = x
+	return
+}
+
+// MarshalBinary encoding.BinaryMarshalerの実装
+func (yh Ymdhms) MarshalBinary() ([]byte, error) {
+	return marshalBinary(yh)
+}
+
+// UnmarshalBinary encoding.BinaryUnmarshalerの実装
+func (yh *Ymdhms) UnmarshalBinary(b []byte) error {
+	n, err := unmarshalBinary(b)
+	if err == nil {
+		*yh = Ymdhms(n)
+	}
+	return err
+}
+
+// MarshalText encoding.TextMarshalerの実装
+func (x YmdhmsMs) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText encoding.TextUnmarshalerの実装
+func (x *YmdhmsMs) UnmarshalText(b []byte) (err error) {
+	var v YmdhmsMs
+	if v, err = ParseYmdhmsMs(string(b)); err != nil {
+		return
+	}
+	*x = v
+	return
+}
+
+// MarshalBinary encoding.BinaryMarshalerの実装
+func (x YmdhmsMs) MarshalBinary() ([]byte, error) {
+	return marshalBinary(x)
+}
+
+// UnmarshalBinary encoding.BinaryUnmarshalerの実装
+func (x *YmdhmsMs) UnmarshalBinary(b []byte) error {
+	n, err := unmarshalBinary(b)
+	if err == nil {
+		*x = YmdhmsMs(n)
+	}
+	return err
+}
+
+// MarshalText encoding.TextMarshalerの実装
+func (yw Yw) MarshalText() ([]byte, error) {
+	return []byte(yw.String()), nil
+}
+
+// UnmarshalText encoding.TextUnmarshalerの実装
+func (yw *Yw) UnmarshalText(b []byte) (err error) {
+	var x Yw
+	if x, err = ParseYw(string(b)); err != nil {
+		return
+	}
+	*yw = x
+	return
+}
+
+// MarshalBinary encoding.BinaryMarshalerの実装
+func (yw Yw) MarshalBinary() ([]byte, error) {
+	return marshalBinary(yw)
+}
+
+// UnmarshalBinary encoding.BinaryUnmarshalerの実装
+func (yw *Yw) UnmarshalBinary(b []byte) error {
+	n, err := unmarshalBinary(b)
+	if err == nil {
+		*yw = Yw(n)
+	}
+	return err
+}
@@ -0,0 +1,118 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestJSONFormat(t *testing.T) {
+	v := struct {
+		Ymd    types.Ymd    `json:"ymd"`
+		Ym     types.Ym     `json:"ym"`
+		Md     types.Md     `json:"md"`
+		Hms    types.Hms    `json:"hms"`
+		Ymdhms types.Ymdhms `json:"ymdhms"`
+		Yw     types.Yw     `json:"yw"`
+	}{20240305, 202403, 305, 103015, 20240305103015, 202410}
+
+	defer types.SetJSONFormat(types.JSONDefault)
+	for _, x := range []struct {
+		f   types.JSONFormat
+		exp string
+	}{
+		{types.JSONDefault, `{"ymd":20240305,"ym":202403,"md":305,"hms":103015,"ymdhms":"20240305103015","yw":202410}`},
+		{types.JSONNumber, `{"ymd":20240305,"ym":202403,"md":305,"hms":103015,"ymdhms":20240305103015,"yw":202410}`},
+		{types.JSONString, `{"ymd":"20240305","ym":"202403","md":"0305","hms":"103015","ymdhms":"20240305103015","yw":"202410"}`},
+		{types.JSONISO8601, `{"ymd":"2024-03-05","ym":"2024-03","md":"--03-05","hms":"10:30:15","ymdhms":"2024-03-05T10:30:15","yw":"2024-W10"}`},
+	} {
+		types.SetJSONFormat(x.f)
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if string(b) != x.exp {
+			t.Errorf("%v: expect(%s) != actual(%s)", x.f, x.exp, b)
+		}
+		act := v
+		act.Ymd, act.Ym, act.Md, act.Hms, act.Ymdhms, act.Yw = 0, 0, 0, 0, 0, 0
+		if err = json.Unmarshal(b, &act); err != nil {
+			t.Errorf("%v: %+v", x.f, err)
+		} else if act != v {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.f, v, act)
+		}
+	}
+}
+
+func TestTextMarshal(t *testing.T) {
+	m := map[types.Ymd]int{20240305: 1, 20240306: 2}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if exp := `{"20240305":1,"20240306":2}`; string(b) != exp {
+		t.Errorf("expect(%s) != actual(%s)", exp, b)
+	}
+	var act map[types.Ymd]int
+	if err = json.Unmarshal(b, &act); err != nil || act[20240306] != 2 {
+		t.Errorf("%v %+v", act, err)
+	}
+
+	type elem struct {
+		XMLName xml.Name     `xml:"e"`
+		Date    types.Ymd    `xml:"date,attr"`
+		Time    types.Ymdhms `xml:"time"`
+	}
+	b, err = xml.Marshal(elem{Date: 20240305, Time: 20240305103015})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if exp := `<e date="20240305"><time>20240305103015</time></e>`; string(b) != exp {
+		t.Errorf("expect(%s) != actual(%s)", exp, b)
+	}
+	var e elem
+	if err = xml.Unmarshal([]byte(`<e date="2024-03-05"><time>2024-03-05T10:30:15</time></e>`), &e); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if e.Date != 20240305 || e.Time != 20240305103015 {
+		t.Errorf("%v", e)
+	}
+
+	var ymd types.Ymd
+	if err = ymd.UnmarshalText([]byte("20241301")); err == nil {
+		t.Errorf("error expected")
+	}
+}
+
+func TestBinaryMarshal(t *testing.T) {
+	type rec struct {
+		Ymd    types.Ymd
+		Ymdhms types.Ymdhms
+		Ms     types.YmdhmsMs
+	}
+	v := rec{20240305, 20240305103015, 20240305103015123}
+	buf := bytes.Buffer{}
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var act rec
+	if err := gob.NewDecoder(&buf).Decode(&act); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if act != v {
+		t.Errorf("expect(%v) != actual(%v)", v, act)
+	}
+
+	b, _ := types.Hms(235959).MarshalBinary()
+	var hms types.Hms
+	if err := hms.UnmarshalBinary(b); err != nil || hms != 235959 {
+		t.Errorf("expect(%v) != actual(%v) %v", 235959, hms, err)
+	}
+	if err := hms.UnmarshalBinary(append(b, 0)); err == nil {
+		t.Errorf("error expected")
+	}
+}
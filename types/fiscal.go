@@ -0,0 +1,271 @@
+package types
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/MineTakaki/go-utils/errors"
+)
+
+type (
+	// FiscalCalendar 会計年度の区切りを表すカレンダー
+	//
+	//	暦月で区切るカレンダー（NewFiscalCalendar）と、
+	//	52/53週で区切る小売業向けのカレンダー（NewRetailCalendar）があります。
+	//	年度は期首の属する暦年で表します（4月始まりの場合、2024/4/1～2025/3/31 は2024年度）
+	FiscalCalendar struct {
+		startMonth int
+		startDay   int
+		weekly     bool
+		endWeekday time.Weekday
+		nearest    bool
+		pattern    WeekPattern
+	}
+
+	// WeekPattern 52/53週カレンダーの四半期内の各月の週数
+	WeekPattern [3]int
+
+	// FiscalPeriod 会計期間上の位置
+	FiscalPeriod struct {
+		Year          int // 年度
+		Half          int // 半期（1: 上期, 2: 下期）
+		Quarter       int // 四半期（1～4）
+		Month         int // 期首からの月数（1～12）
+		CalendarMonth int // 会計月に対応する暦月（1～12）
+	}
+)
+
+var (
+	// Pattern445 4-4-5週
+	Pattern445 = WeekPattern{4, 4, 5}
+	// Pattern454 4-5-4週
+	Pattern454 = WeekPattern{4, 5, 4}
+	// Pattern544 5-4-4週
+	Pattern544 = WeekPattern{5, 4, 4}
+)
+
+// NewFiscalCalendar 期首の月日を指定して暦月で区切るカレンダーを生成します
+//
+//	各会計月は期首の日から始まります（0421の場合、4月度は4/21～5/20）。
+//	日が月末を超える場合は月末日を開始日とします
+func NewFiscalCalendar(start Md) (c FiscalCalendar, err error) {
+	m, d := start.Part()
+	if _, err = ValidateMd(m, d); err != nil {
+		return
+	}
+	return FiscalCalendar{startMonth: m, startDay: d}, nil
+}
+
+// NewRetailCalendar 52/53週で区切る小売業向けのカレンダーを生成します
+//
+//	年度はendMonthの最終endWeekdayで終了します。nearestがtrueの場合はendMonthの月末日に最も近いendWeekdayで終了します。
+//	各四半期は13週でpatternの週数で各月に分割し、53週目は第12月に加えます
+func NewRetailCalendar(endMonth int, endWeekday time.Weekday, nearest bool, pattern WeekPattern) (c FiscalCalendar, err error) {
+	if endMonth < 1 || endMonth > 12 {
+		return c, errors.Wrapf(ErrValidate, "incorrect month value. m:%d", endMonth)
+	}
+	if endWeekday < time.Sunday || endWeekday > time.Saturday {
+		return c, errors.Wrapf(ErrValidate, "incorrect weekday value. wd:%d", endWeekday)
+	}
+	if pattern[0] < 1 || pattern[1] < 1 || pattern[2] < 1 || pattern[0]+pattern[1]+pattern[2] != 13 {
+		return c, errors.Wrapf(ErrValidate, "incorrect week pattern. %v", pattern)
+	}
+	return FiscalCalendar{
+		startMonth: endMonth%12 + 1,
+		startDay:   1,
+		weekly:     true,
+		endWeekday: endWeekday,
+		nearest:    nearest,
+		pattern:    pattern,
+	}, nil
+}
+
+// IsRetail 52/53週のカレンダーか判定します
+func (c FiscalCalendar) IsRetail() bool {
+	return c.weekly
+}
+
+// nominal 会計月に対応する暦月を取得します
+func (c FiscalCalendar) nominal(fy, m int) Ym {
+	return Ym(fy*100+c.startMonth).Add(0, m-1)
+}
+
+// yearEnd 52/53週カレンダーの年度末日を取得します
+func (c FiscalCalendar) yearEnd(fy int) Ymd {
+	last := c.nominal(fy, 12).Last()
+	wd := last.Weekday()
+	back := (int(wd) - int(c.endWeekday) + 7) % 7
+	if c.nearest && back > 3 {
+		return last.Add(0, 0, 7-back)
+	}
+	return last.Add(0, 0, -back)
+}
+
+// monthStart 会計月の開始日を取得します（m = 13 の場合は翌年度の期首）
+func (c FiscalCalendar) monthStart(fy, m int) Ymd {
+	if !c.weekly {
+		return c.nominal(fy, m).Ymd(c.startDay)
+	}
+	start := c.yearEnd(fy - 1).Next()
+	if m > 12 {
+		return c.yearEnd(fy).Next()
+	}
+	weeks := 0
+	for i := 1; i < m; i++ {
+		weeks += c.pattern[(i-1)%3]
+	}
+	return start.Add(0, 0, weeks*7)
+}
+
+func (c FiscalCalendar) monthsRange(fy, from, to int) YmdRange {
+	return YmdRange{From: c.monthStart(fy, from), To: c.monthStart(fy, to+1).Prev()}
+}
+
+// Year 日付の年度を取得します
+func (c FiscalCalendar) Year(ymd Ymd) int {
+	if ymd == 0 {
+		return 0
+	}
+	fy := ymd.Year() + 1
+	for c.monthStart(fy, 1) > ymd {
+		fy--
+	}
+	return fy
+}
+
+// Period 日付の会計期間上の位置を取得します
+func (c FiscalCalendar) Period(ymd Ymd) (p FiscalPeriod) {
+	if ymd == 0 {
+		return
+	}
+	fy := c.Year(ymd)
+	m := 12
+	for m > 1 && c.monthStart(fy, m) > ymd {
+		m--
+	}
+	return c.period(fy, m)
+}
+
+// PeriodOfYm 年月（会計月に対応する暦月）の会計期間上の位置を取得します
+func (c FiscalCalendar) PeriodOfYm(ym Ym) (p FiscalPeriod) {
+	if ym == 0 {
+		return
+	}
+	y, m := ym.Part()
+	if m < c.startMonth {
+		y--
+	}
+	return c.period(y, (m-c.startMonth+12)%12+1)
+}
+
+func (c FiscalCalendar) period(fy, m int) FiscalPeriod {
+	return FiscalPeriod{
+		Year:          fy,
+		Half:          (m-1)/6 + 1,
+		Quarter:       (m-1)/3 + 1,
+		Month:         m,
+		CalendarMonth: c.nominal(fy, m).Month(),
+	}
+}
+
+// YearRange 年度の期間を取得します
+func (c FiscalCalendar) YearRange(fy int) YmdRange {
+	return c.monthsRange(fy, 1, 12)
+}
+
+// HalfRange 半期（1: 上期, 2: 下期）の期間を取得します
+func (c FiscalCalendar) HalfRange(fy, h int) YmdRange {
+	if h < 1 || h > 2 {
+		return YmdRange{}
+	}
+	return c.monthsRange(fy, h*6-5, h*6)
+}
+
+// QuarterRange 四半期（1～4）の期間を取得します
+func (c FiscalCalendar) QuarterRange(fy, q int) YmdRange {
+	if q < 1 || q > 4 {
+		return YmdRange{}
+	}
+	return c.monthsRange(fy, q*3-2, q*3)
+}
+
+// MonthRange 会計月（1～12）の期間を取得します
+func (c FiscalCalendar) MonthRange(fy, m int) YmdRange {
+	if m < 1 || m > 12 {
+		return YmdRange{}
+	}
+	return c.monthsRange(fy, m, m)
+}
+
+// Weeks 年度の週数を取得します（暦月で区切るカレンダーは0を返します）
+func (c FiscalCalendar) Weeks(fy int) int {
+	if !c.weekly {
+		return 0
+	}
+	return c.YearRange(fy).Days() / 7
+}
+
+// IsZero 値が設定されていないか判定します
+func (p FiscalPeriod) IsZero() bool {
+	return p.Year == 0
+}
+
+// YearLabel 年度の名称を取得します（2024年度）
+func (p FiscalPeriod) YearLabel() string {
+	if p.IsZero() {
+		return ""
+	}
+	return strconv.Itoa(p.Year) + "年度"
+}
+
+// HalfLabel 半期の名称を取得します（2024年度上期）
+func (p FiscalPeriod) HalfLabel() string {
+	if p.IsZero() {
+		return ""
+	}
+	if p.Half == 1 {
+		return p.YearLabel() + "上期"
+	}
+	return p.YearLabel() + "下期"
+}
+
+// QuarterLabel 四半期の名称を取得します（2024年度第2四半期）
+func (p FiscalPeriod) QuarterLabel() string {
+	if p.IsZero() {
+		return ""
+	}
+	return p.YearLabel() + "第" + strconv.Itoa(p.Quarter) + "四半期"
+}
+
+// MonthLabel 会計月の名称を取得します（2024年度6月度）
+func (p FiscalPeriod) MonthLabel() string {
+	if p.IsZero() {
+		return ""
+	}
+	return p.YearLabel() + strconv.Itoa(p.CalendarMonth) + "月度"
+}
+
+// String string型変換（四半期の名称）
+func (p FiscalPeriod) String() string {
+	return p.QuarterLabel()
+}
+
+// TermStart 日付の属する会計期間の開始日を取得します（会計期間ごとの集計のキー）
+//
+//	monthsは期間の月数（1: 月度, 3: 四半期, 6: 半期, 12: 年度）で、12の約数以外の場合は0を返します
+func (c FiscalCalendar) TermStart(ymd Ymd, months int) Ymd {
+	if ymd == 0 || months < 1 || 12%months != 0 {
+		return 0
+	}
+	p := c.Period(ymd)
+	return c.monthStart(p.Year, (p.Month-1)/months*months+1)
+}
+
+// NextTermStart 日付の属する会計期間の次の期間の開始日を取得します
+func (c FiscalCalendar) NextTermStart(ymd Ymd, months int) Ymd {
+	if ymd = c.TermStart(ymd, months); ymd == 0 {
+		return 0
+	}
+	p := c.Period(ymd)
+	return c.monthsRange(p.Year, p.Month, p.Month+months-1).To.Next()
+}
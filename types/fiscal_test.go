@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestFiscalCalendar(t *testing.T) {
+	c, err := types.NewFiscalCalendar(401)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, x := range []struct {
+		ymd   Ymd
+		exp   types.FiscalPeriod
+		label string
+	}{
+		{20240401, types.FiscalPeriod{Year: 2024, Half: 1, Quarter: 1, Month: 1, CalendarMonth: 4}, "2024年度第1四半期"},
+		{20240705, types.FiscalPeriod{Year: 2024, Half: 1, Quarter: 2, Month: 4, CalendarMonth: 7}, "2024年度第2四半期"},
+		{20250331, types.FiscalPeriod{Year: 2024, Half: 2, Quarter: 4, Month: 12, CalendarMonth: 3}, "2024年度第4四半期"},
+	} {
+		act := c.Period(x.ymd)
+		if act != x.exp {
+			t.Errorf("%v: expect(%+v) != actual(%+v)", x.ymd, x.exp, act)
+		}
+		if act.QuarterLabel() != x.label {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.ymd, x.label, act.QuarterLabel())
+		}
+		if p := c.PeriodOfYm(x.ymd.YearMonth()); p != x.exp {
+			t.Errorf("%v: expect(%+v) != actual(%+v)", x.ymd, x.exp, p)
+		}
+	}
+	if act := c.Period(20241001).HalfLabel(); act != "2024年度下期" {
+		t.Errorf("expect(%v) != actual(%v)", "2024年度下期", act)
+	}
+	if act := c.Period(20240615).MonthLabel(); act != "2024年度6月度" {
+		t.Errorf("expect(%v) != actual(%v)", "2024年度6月度", act)
+	}
+	if act, exp := c.QuarterRange(2024, 4), (YmdRange{From: 20250101, To: 20250331}); act != exp {
+		t.Errorf("expect(%v) != actual(%v)", exp, act)
+	}
+	if act, exp := c.HalfRange(2024, 1), (YmdRange{From: 20240401, To: 20240930}); act != exp {
+		t.Errorf("expect(%v) != actual(%v)", exp, act)
+	}
+
+	c, _ = types.NewFiscalCalendar(421)
+	if act, exp := c.MonthRange(2024, 1), (YmdRange{From: 20240421, To: 20240520}); act != exp {
+		t.Errorf("expect(%v) != actual(%v)", exp, act)
+	}
+	if act := c.Year(20240420); act != 2023 {
+		t.Errorf("expect(%v) != actual(%v)", 2023, act)
+	}
+
+	if _, err = types.NewFiscalCalendar(1301); err == nil {
+		t.Errorf("error expected")
+	}
+}
+
+func TestRetailCalendar(t *testing.T) {
+	// 1月末日に最も近い土曜日で終了する4-5-4カレンダー
+	c, err := types.NewRetailCalendar(1, time.Saturday, true, types.Pattern454)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, x := range []struct {
+		fy    int
+		exp   YmdRange
+		weeks int
+	}{
+		{2022, YmdRange{From: 20220130, To: 20230128}, 52},
+		{2023, YmdRange{From: 20230129, To: 20240203}, 53},
+		{2024, YmdRange{From: 20240204, To: 20250201}, 52},
+	} {
+		if act := c.YearRange(x.fy); act != x.exp {
+			t.Errorf("%d: expect(%v) != actual(%v)", x.fy, x.exp, act)
+		}
+		if act := c.Weeks(x.fy); act != x.weeks {
+			t.Errorf("%d: expect(%v) != actual(%v)", x.fy, x.weeks, act)
+		}
+	}
+	if act, exp := c.MonthRange(2024, 2), (YmdRange{From: 20240303, To: 20240406}); act != exp {
+		t.Errorf("expect(%v) != actual(%v)", exp, act)
+	}
+	if act, exp := c.MonthRange(2023, 12), (YmdRange{From: 20231231, To: 20240203}); act != exp {
+		t.Errorf("expect(%v) != actual(%v)", exp, act)
+	}
+	p := c.Period(20240202)
+	if p.Year != 2023 || p.Month != 12 || p.Quarter != 4 {
+		t.Errorf("%+v", p)
+	}
+
+	// 12月の最終金曜日で終了する4-4-5カレンダー
+	c, _ = types.NewRetailCalendar(12, time.Friday, false, types.Pattern445)
+	if act, exp := c.YearRange(2024), (YmdRange{From: 20231230, To: 20241227}); act != exp {
+		t.Errorf("expect(%v) != actual(%v)", exp, act)
+	}
+	if act := c.Period(20231230).QuarterLabel(); act != "2024年度第1四半期" {
+		t.Errorf("expect(%v) != actual(%v)", "2024年度第1四半期", act)
+	}
+
+	if _, err = types.NewRetailCalendar(1, time.Saturday, true, types.WeekPattern{4, 4, 4}); err == nil {
+		t.Errorf("error expected")
+	}
+}
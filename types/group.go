@@ -0,0 +1,119 @@
+package types
+
+import (
+	"sort"
+
+	"github.com/MineTakaki/go-utils/types/decimal"
+)
+
+// Bucket 日付・時刻型のキーでまとめた要素
+type Bucket[K Temporal[K], V any] struct {
+	Key   K
+	Items []V
+}
+
+// GroupBy キーでまとめた要素をキーの昇順のバケットで取得します
+//
+//	各バケット内の要素は元のスライスの順序を保ちます。キーが0の要素も0のバケットにまとめます
+func GroupBy[K Temporal[K], V any](items []V, key func(V) K) []Bucket[K, V] {
+	idx := map[K]int{}
+	var v []Bucket[K, V]
+	for _, item := range items {
+		k := key(item)
+		i, ok := idx[k]
+		if !ok {
+			i = len(v)
+			idx[k] = i
+			v = append(v, Bucket[K, V]{Key: k})
+		}
+		v[i].Items = append(v[i].Items, item)
+	}
+	sort.SliceStable(v, func(i, j int) bool { return v[i].Key < v[j].Key })
+	return v
+}
+
+// GroupByFill キーでまとめた要素をfrom～to（両端を含む）のすべての期間のバケットで取得します
+//
+//	nextはキーから次の期間のキーを取得する関数です（Ym.Next, Yw.Next 等）。
+//	要素のない期間は空のバケットになり、範囲外のキーの要素は除外します
+func GroupByFill[K Temporal[K], V any](items []V, key func(V) K, from, to K, next func(K) K) []Bucket[K, V] {
+	var v []Bucket[K, V]
+	idx := map[K]int{}
+	for k := from; k != 0 && k <= to; {
+		idx[k] = len(v)
+		v = append(v, Bucket[K, V]{Key: k})
+		n := next(k)
+		if n <= k {
+			break
+		}
+		k = n
+	}
+	for _, item := range items {
+		if i, ok := idx[key(item)]; ok {
+			v[i].Items = append(v[i].Items, item)
+		}
+	}
+	return v
+}
+
+// Reduce バケット内の要素を集計します
+func Reduce[K Temporal[K], V any, R any](b Bucket[K, V], init R, fn func(R, V) R) R {
+	r := init
+	for _, item := range b.Items {
+		r = fn(r, item)
+	}
+	return r
+}
+
+// Len 要素の数を取得します
+func (b Bucket[K, V]) Len() int {
+	return len(b.Items)
+}
+
+// IsEmpty 要素がないか判定します
+func (b Bucket[K, V]) IsEmpty() bool {
+	return len(b.Items) == 0
+}
+
+// SumDecimal 要素の値の合計を取得します（要素がない場合は0）
+func (b Bucket[K, V]) SumDecimal(f func(V) decimal.Decimal) decimal.Decimal {
+	sum := decimal.Zero
+	for _, item := range b.Items {
+		sum = sum.Add(f(item))
+	}
+	return sum
+}
+
+// AvgDecimal 要素の値の平均を取得します（要素がない場合はNULL）
+func (b Bucket[K, V]) AvgDecimal(f func(V) decimal.Decimal) decimal.NullDecimal {
+	if len(b.Items) == 0 {
+		return decimal.Null
+	}
+	return b.SumDecimal(f).Div(decimal.NewFromInt(int64(len(b.Items)))).Nullable()
+}
+
+// SumNullDecimal NULLを除いた要素の値の合計を取得します（すべてNULLの場合はNULL）
+func (b Bucket[K, V]) SumNullDecimal(f func(V) decimal.NullDecimal) decimal.NullDecimal {
+	sum, _ := b.sumNullDecimal(f)
+	return sum
+}
+
+// AvgNullDecimal NULLを除いた要素の値の平均を取得します（すべてNULLの場合はNULL）
+func (b Bucket[K, V]) AvgNullDecimal(f func(V) decimal.NullDecimal) decimal.NullDecimal {
+	sum, n := b.sumNullDecimal(f)
+	if n == 0 {
+		return decimal.Null
+	}
+	return sum.Decimal.Div(decimal.NewFromInt(int64(n))).Nullable()
+}
+
+func (b Bucket[K, V]) sumNullDecimal(f func(V) decimal.NullDecimal) (sum decimal.NullDecimal, n int) {
+	for _, item := range b.Items {
+		if x := f(item); x.Valid {
+			sum.Decimal = sum.Decimal.Add(x.Decimal)
+			sum.Valid = true
+			n++
+		}
+	}
+	return
+}
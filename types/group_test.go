@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/MineTakaki/go-utils/types"
+	"github.com/MineTakaki/go-utils/types/decimal"
+)
+
+type sale struct {
+	At     types.Ymdhms
+	Amount decimal.Decimal
+	Cost   decimal.NullDecimal
+}
+
+var _sales = []sale{
+	{20240305103000, decimal.NewFromInt(100), decimal.NewFromInt(60).Nullable()},
+	{20240115090000, decimal.NewFromInt(200), decimal.Null},
+	{20240320103500, decimal.NewFromInt(50), decimal.NewFromInt(30).Nullable()},
+	{20240510230000, decimal.NewFromInt(30), decimal.Null},
+}
+
+func TestGroupBy(t *testing.T) {
+	b := types.GroupBy(_sales, func(s sale) types.Ym { return s.At.Ym() })
+	if len(b) != 3 {
+		t.Fatalf("expect(%v) != actual(%v)", 3, len(b))
+	}
+	amount := func(s sale) decimal.Decimal { return s.Amount }
+	cost := func(s sale) decimal.NullDecimal { return s.Cost }
+	for i, x := range []struct {
+		key      types.Ym
+		n        int
+		sum, avg string
+		cost     string
+	}{
+		{202401, 1, "200", "200", ""},
+		{202403, 2, "150", "75", "90"},
+		{202405, 1, "30", "30", ""},
+	} {
+		if b[i].Key != x.key || b[i].Len() != x.n {
+			t.Errorf("expect(%v, %v) != actual(%v, %v)", x.key, x.n, b[i].Key, b[i].Len())
+		}
+		if act := b[i].SumDecimal(amount).String(); act != x.sum {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.key, x.sum, act)
+		}
+		if act := b[i].AvgDecimal(amount).String(); act != x.avg {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.key, x.avg, act)
+		}
+		if act := b[i].SumNullDecimal(cost).String(); act != x.cost {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.key, x.cost, act)
+		}
+	}
+	// 3月の2件は同じ時間帯（10時台）
+	h := types.GroupBy(_sales, func(s sale) types.Ymdhms { return s.At.StartOfHour() })
+	if len(h) != 4 || h[1].Key != 20240305100000 {
+		t.Errorf("%v", h)
+	}
+	n := types.Reduce(b[1], 0, func(n int, s sale) int { return n + int(s.Amount.IntPart()) })
+	if n != 150 {
+		t.Errorf("expect(%v) != actual(%v)", 150, n)
+	}
+}
+
+func TestGroupByFill(t *testing.T) {
+	b := types.GroupByFill(_sales, func(s sale) types.Ym { return s.At.Ym() }, 202402, 202405, types.Ym.Next)
+	exp := []struct {
+		key types.Ym
+		n   int
+	}{{202402, 0}, {202403, 2}, {202404, 0}, {202405, 1}}
+	if len(b) != len(exp) {
+		t.Fatalf("expect(%v) != actual(%v)", len(exp), len(b))
+	}
+	for i, x := range exp {
+		if b[i].Key != x.key || b[i].Len() != x.n {
+			t.Errorf("expect(%v, %v) != actual(%v, %v)", x.key, x.n, b[i].Key, b[i].Len())
+		}
+	}
+	if act := b[0].AvgDecimal(func(s sale) decimal.Decimal { return s.Amount }); act.Valid {
+		t.Errorf("expect(NULL) != actual(%v)", act)
+	}
+
+	w := types.GroupByFill(_sales, func(s sale) types.Yw { return s.At.Ymd().Yw() }, 202410, 202413, types.Yw.Next)
+	if len(w) != 4 || w[0].Len() != 1 || w[2].Len() != 1 {
+		t.Errorf("%v", w)
+	}
+}
+
+func TestFiscalTermBucket(t *testing.T) {
+	c, _ := types.NewFiscalCalendar(401)
+	key := func(s sale) types.Ymd { return c.TermStart(s.At.Ymd(), 3) }
+	next := func(k types.Ymd) types.Ymd { return c.NextTermStart(k, 3) }
+	b := types.GroupByFill(_sales, key, 20231001, 20240401, next)
+	exp := []struct {
+		key types.Ymd
+		n   int
+	}{{20231001, 0}, {20240101, 3}, {20240401, 1}}
+	if len(b) != len(exp) {
+		t.Fatalf("expect(%v) != actual(%v)", len(exp), len(b))
+	}
+	for i, x := range exp {
+		if b[i].Key != x.key || b[i].Len() != x.n {
+			t.Errorf("expect(%v, %v) != actual(%v, %v)", x.key, x.n, b[i].Key, b[i].Len())
+		}
+	}
+	if act := c.TermStart(20240305, 5); act != 0 {
+		t.Errorf("expect(0) != actual(%v)", act)
+	}
+}
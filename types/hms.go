@@ -16,13 +16,9 @@ type (
 	Hms int
 
 	//HmsSlice Hms型のスライス
-	HmsSlice []Hms
+	HmsSlice = Slice[Hms]
 )
 
-func (hms HmsSlice) Len() int           { return len(hms) }
-func (hms HmsSlice) Less(i, j int) bool { return hms[i] < hms[j] }
-func (hms HmsSlice) Swap(i, j int)      { hms[i], hms[j] = hms[j], hms[i] }
-
 // ToHms 時分秒からHms型に変換します
 func ToHms(h, m, s int) (hms Hms, err error) {
 	_, err = ValidateHms(h, m, s)
@@ -103,13 +99,15 @@ func (hms *Hms) UnmarshalJSON(b []byte) (err error) {
 	return
 }
 
-// MarshalJSON json.Marshalerの実装
-func (hms *Hms) MarshalJSON() ([]byte, error) {
-	return json.Marshal(int(*hms))
+// MarshalJSON json.Marshalerの実装（表現はSetJSONFormatで変更できます）
+func (hms Hms) MarshalJSON() ([]byte, error) {
+	return marshalJSON(hms, JSONNumber, hms.ISOString)
 }
 
-// MarshalLogObject zapcore.ObjectMarshalerの実装
-func (hms *Hms) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+// MarshalLogObject zapcore.ObjectMarshalerの実装（{"hms":"093000"}）
+//
+//	LogValueと同じ文字列の形式で出力する場合はzapx.Hmsを使用します
+func (hms Hms) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("hms", hms.String())
 	return nil
 }
@@ -155,6 +153,12 @@ func (hms Hms) Parts() []int {
 	return v
 }
 
+// Seconds 0時からの経過秒数を取得します
+func (hms Hms) Seconds() int {
+	h, m, s := hms.Part()
+	return (h*60+m)*60 + s
+}
+
 // Prev 1秒前の時間を取得します
 func (hms Hms) Prev() Hms {
 	return hms.Add(0, 0, -1)
@@ -183,7 +187,7 @@ func (hms Hms) Add(dh, dm, ds int) Hms {
 	h, m, s := hms.Part()
 
 	//状態を正常化します
-	h, m, s = AdjustHms(h, m, s+ds)
+	h, m, s = AdjustHms(h+dh, m+dm, s+ds)
 
 	return Hms(h*10000 + m*100 + s)
 }
@@ -194,19 +198,36 @@ func (hms Hms) Adjust() Hms {
 }
 
 // Scan 文字列から時分秒を読み取ります
+//
+//	書式に一致しない文字列は寛容に読み取ります（"10:30", "10時30分", "１０：３０" 等）
 func (hms *Hms) Scan(i interface{}) (err error) {
 	if conv.IsEmpty(i) {
 		*hms = 0
 		return nil
 	}
+	if p, ok := i.(DateParts); ok {
+		*hms = p.Hms()
+		return nil
+	}
 	if n, ok := conv.Int(i); ok {
 		*hms = Hms(n)
 		return
 	}
+	if s, ok := i.(string); ok {
+		if p, err := ParseLayout("HH:mm:ss", s); err == nil {
+			*hms = p.Hms()
+			return nil
+		}
+		var x Hms
+		if x, err = scanLenient(s, lenientHms, DateParts.Hms); err == nil {
+			*hms = x
+		}
+		return
+	}
 	return errors.WithStack(ErrValidate)
 }
 
-// Between 二つの日付の間に入るか判定します
+// Between 二つの時刻の間（両端を含む）に入るか判定します。f > t の場合は0時をまたぐ範囲として扱います
 func (hms Hms) Between(f, t Hms) bool {
 	if hms == 0 || f == 0 || t == 0 {
 		return false
@@ -214,7 +235,7 @@ func (hms Hms) Between(f, t Hms) bool {
 	if f > t {
 		return hms >= f || hms <= t
 	}
-	return f <= hms && hms >= t
+	return f <= hms && hms <= t
 }
 
 // Compare Hms同志を比較します
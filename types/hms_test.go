@@ -57,3 +57,40 @@ func TestHmsString(t *testing.T) {
 		}
 	}
 }
+
+func TestHmsAdd(t *testing.T) {
+	for _, x := range []struct {
+		v       types.Hms
+		h, m, s int
+		exp     types.Hms
+	}{
+		{100000, 1, 0, 0, 110000},
+		{100000, 0, 30, 0, 103000},
+		{100000, 0, 0, 90, 100130},
+		{100000, 1, -30, 0, 103000},
+	} {
+		if act := x.v.Add(x.h, x.m, x.s); x.exp != act {
+			t.Errorf("%v.Add(%d,%d,%d) : exp(%v) != act(%v)", x.v, x.h, x.m, x.s, x.exp, act)
+		}
+	}
+}
+
+func TestHmsBetween(t *testing.T) {
+	for _, x := range []struct {
+		hms, f, t types.Hms
+		exp       bool
+	}{
+		{120000, 90000, 170000, true},
+		{90000, 90000, 170000, true},
+		{170000, 90000, 170000, true},
+		{80000, 90000, 170000, false},
+		{180000, 90000, 170000, false},
+		{230000, 220000, 60000, true},
+		{50000, 220000, 60000, true},
+		{120000, 220000, 60000, false},
+	} {
+		if act := x.hms.Between(x.f, x.t); act != x.exp {
+			t.Errorf("%v.Between(%v, %v): expect(%v) != actual(%v)", x.hms, x.f, x.t, x.exp, act)
+		}
+	}
+}
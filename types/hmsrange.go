@@ -0,0 +1,289 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/MineTakaki/go-utils/conv"
+	"github.com/MineTakaki/go-utils/errors"
+	"go.uber.org/zap/zapcore"
+)
+
+// HmsRange 時刻の範囲（Fromを含みToを含まない半開区間）
+//
+//	To <= From の場合は0時をまたぐ範囲（22:00～06:00 等）として扱い、From == To の場合は空の範囲として扱います。
+//	24時以降の表記（25:30 等、ParseHmsExtendedを参照）も使用できます。
+//	Hms型と異なり、0は未設定ではなく0時（00:00:00）を表します
+type HmsRange struct {
+	From Hms `json:"from"`
+	To   Hms `json:"to"`
+}
+
+// ParseHmsExtended 24時以降の表記（24:00:00～47:59:59）を許可してHms型に変換します
+//
+//	シフトの終了時刻等、翌日の時刻を当日の24時以降として表す場合に使用します（"25:30" → 253000）
+func ParseHmsExtended(i interface{}) (hms Hms, err error) {
+	if err = hms.Scan(i); err != nil {
+		s, ok := i.(string)
+		if !ok {
+			return
+		}
+		var p DateParts
+		if p, err = lenientParts(s, lenientHms); err != nil {
+			return
+		}
+		hms = p.Hms()
+	}
+	_, err = hms.ValidateExtended()
+	return
+}
+
+// ValidateExtended 24時以降の表記を含めて時分秒が正しいか確認します
+func (hms Hms) ValidateExtended() (bool, error) {
+	h, m, s := hms.Part()
+	return ValidateHmsExtended(h, m, s)
+}
+
+// IsExtended 24時以降の表記か判定します
+func (hms Hms) IsExtended() bool {
+	return hms >= 240000
+}
+
+// Normalize 24時以降の表記を翌日の時刻に変換し、日数のずれ（0または1）とともに返します
+func (hms Hms) Normalize() (Hms, int) {
+	if hms.IsExtended() {
+		return hms - 240000, 1
+	}
+	return hms, 0
+}
+
+// hmsOfSeconds 0時からの経過秒数をHms型に変換します（24時以降の表記になる場合があります）
+func hmsOfSeconds(n int) Hms {
+	return Hms(n/3600*10000 + n/60%60*100 + n%60)
+}
+
+// At 日付と時刻を組み合わせてYmdhms型に変換します
+//
+//	時刻が24時以降の表記の場合は翌日の日時になります（20240305 + 253000 → 20240306013000）
+func (ymd Ymd) At(hms Hms) Ymdhms {
+	if ymd == 0 {
+		return 0
+	}
+	hms, d := hms.Normalize()
+	if d != 0 {
+		ymd = ymd.Add(0, 0, d)
+	}
+	return Ymdhms(int64(ymd)*1000000 + int64(hms))
+}
+
+// ExtendedHms 基準日からの時刻を取得します。翌日の日時の場合は24時以降の表記になります
+//
+//	基準日と翌日以外の日時の場合はエラーになります
+func (yh Ymdhms) ExtendedHms(base Ymd) (Hms, error) {
+	switch ymd := yh.Ymd(); {
+	case ymd == base:
+		return yh.Hms(), nil
+	case ymd == base.Next():
+		return yh.Hms() + 240000, nil
+	}
+	return 0, errors.Wrapf(ErrValidate, "out of extended hours : %v, base %v", yh, base)
+}
+
+// NewHmsRange 開始時刻と終了時刻から範囲を生成します
+func NewHmsRange(from, to Hms) HmsRange {
+	return HmsRange{From: from, To: to}
+}
+
+// seconds 0時からの経過秒数で範囲を取得します（0時をまたぐ場合は to が24時間を超えます）
+func (r HmsRange) seconds() (from, to int) {
+	from, to = r.From.Seconds(), r.To.Seconds()
+	if to <= from {
+		to += secondsPerDay
+	}
+	return
+}
+
+// IsEmpty 空の範囲か判定します
+func (r HmsRange) IsEmpty() bool {
+	return r.From.Seconds() == r.To.Seconds()
+}
+
+// IsOvernight 0時をまたぐ範囲か判定します
+func (r HmsRange) IsOvernight() bool {
+	if r.IsEmpty() {
+		return false
+	}
+	_, t := r.seconds()
+	return t > secondsPerDay
+}
+
+// String string型変換
+func (r HmsRange) String() string {
+	return r.From.String() + "-" + r.To.String()
+}
+
+// Seconds 範囲の秒数を取得します
+func (r HmsRange) Seconds() int {
+	if r.IsEmpty() {
+		return 0
+	}
+	f, t := r.seconds()
+	return t - f
+}
+
+// Duration 範囲の長さを取得します
+func (r HmsRange) Duration() time.Duration {
+	return time.Duration(r.Seconds()) * time.Second
+}
+
+// Contains 時刻が範囲に含まれるか判定します（24時以降の表記の時刻も判定できます）
+func (r HmsRange) Contains(hms Hms) bool {
+	if r.IsEmpty() {
+		return false
+	}
+	f, t := r.seconds()
+	x := hms.Seconds()
+	for _, d := range []int{-secondsPerDay, 0, secondsPerDay} {
+		if f <= x+d && x+d < t {
+			return true
+		}
+	}
+	return false
+}
+
+// Overlaps 範囲が重なるか判定します（0時をまたぐ範囲は翌日の部分も含めて判定します）
+func (r HmsRange) Overlaps(o HmsRange) bool {
+	if r.IsEmpty() || o.IsEmpty() {
+		return false
+	}
+	f1, t1 := r.seconds()
+	f2, t2 := o.seconds()
+	for _, d := range []int{-secondsPerDay, 0, secondsPerDay} {
+		if f1 < t2+d && f2+d < t1 {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitAtMidnight 0時で範囲を分割します
+//
+//	0時をまたぐ範囲は当日分（From～0時）と翌日分（0時～To）に分割します。
+//	開始時刻が24時以降の表記の場合は翌日の時刻に変換した範囲を返します
+func (r HmsRange) SplitAtMidnight() []HmsRange {
+	if r.IsEmpty() {
+		return nil
+	}
+	f, t := r.seconds()
+	if f >= secondsPerDay {
+		f, t = f-secondsPerDay, t-secondsPerDay
+	}
+	if t <= secondsPerDay {
+		return []HmsRange{{From: hmsOfSeconds(f), To: hmsOfSeconds(t % secondsPerDay)}}
+	}
+	return []HmsRange{
+		{From: hmsOfSeconds(f), To: 0},
+		{From: 0, To: hmsOfSeconds(t - secondsPerDay)},
+	}
+}
+
+// On 指定した日付の日時の区間（From, Toを含む閉区間）に変換します
+//
+//	範囲の終了時刻は含まないため、Toは終了時刻の1秒前になります。0時をまたぐ場合は翌日までの区間になります
+func (r HmsRange) On(ymd Ymd) YmdhmsRange {
+	if ymd == 0 || r.IsEmpty() {
+		return YmdhmsRange{}
+	}
+	f, t := r.seconds()
+	return YmdhmsRange{
+		From: ymd.At(hmsOfSeconds(f)),
+		To:   ymd.Add(0, 0, t/secondsPerDay).At(hmsOfSeconds(t % secondsPerDay)).Prev(),
+	}
+}
+
+// Scan 範囲を読み取ります（"22:00-06:00", "220000～060000", "22:00～25:30" 等）
+func (r *HmsRange) Scan(i interface{}) (err error) {
+	if conv.IsEmpty(i) {
+		*r = HmsRange{}
+		return nil
+	}
+	var s string
+	switch v := i.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return errors.Wrapf(ErrUnkownType, "%T", i)
+	}
+	f, t, _, ok := splitRangeText(normalizeWidth(s))
+	if !ok {
+		return errors.Wrapf(ErrValidate, "incorrect range : '%s'", s)
+	}
+	var x HmsRange
+	if x.From, err = ParseHmsExtended(f); err != nil {
+		return
+	}
+	if x.To, err = ParseHmsExtended(t); err != nil {
+		return
+	}
+	*r = x
+	return nil
+}
+
+// Value driver.Valuerインターフェイスの実装
+func (r HmsRange) Value() (driver.Value, error) {
+	if r.IsEmpty() {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+// UnmarshalJSON json.Unmarshalerインターフェイスの実装
+func (r *HmsRange) UnmarshalJSON(b []byte) (err error) {
+	if s := strings.TrimSpace(string(b)); s == "null" || s == `""` {
+		*r = HmsRange{}
+		return nil
+	} else if strings.HasPrefix(s, `"`) {
+		var x string
+		if err = errors.WithStack(json.Unmarshal(b, &x)); err != nil {
+			return
+		}
+		return r.Scan(x)
+	}
+	var x struct {
+		From int `json:"from"`
+		To   int `json:"to"`
+	}
+	if err = errors.WithStack(json.Unmarshal(b, &x)); err != nil {
+		return
+	}
+	v := HmsRange{From: Hms(x.From), To: Hms(x.To)}
+	if _, err = v.From.ValidateExtended(); err != nil {
+		return
+	}
+	if _, err = v.To.ValidateExtended(); err != nil {
+		return
+	}
+	*r = v
+	return
+}
+
+// MarshalJSON json.Marshalerの実装
+func (r HmsRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		From int `json:"from"`
+		To   int `json:"to"`
+	}{int(r.From), int(r.To)})
+}
+
+// MarshalLogObject zapcore.ObjectMarshalerの実装（{"from":"220000","to":"060000"}）
+//
+//	LogValueと同じ文字列の形式で出力する場合はzapx.HmsRangeを使用します
+func (r HmsRange) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("from", r.From.String())
+	enc.AddString("to", r.To.String())
+	return nil
+}
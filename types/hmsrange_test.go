@@ -0,0 +1,131 @@
+package types_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestHmsRange(t *testing.T) {
+	for _, x := range []struct {
+		r         types.HmsRange
+		dur       time.Duration
+		overnight bool
+		in, out   []types.Hms
+	}{
+		{types.NewHmsRange(90000, 170000), 8 * time.Hour, false, []types.Hms{90000, 165959}, []types.Hms{85959, 170000}},
+		{types.NewHmsRange(220000, 60000), 8 * time.Hour, true, []types.Hms{220000, 0, 55959, 253000}, []types.Hms{60000, 120000}},
+		{types.NewHmsRange(220000, 293000), 7*time.Hour + 30*time.Minute, true, []types.Hms{230000, 13000, 253000}, []types.Hms{73000, 120000}},
+		{types.NewHmsRange(220000, 0), 2 * time.Hour, false, []types.Hms{235959}, []types.Hms{0, 215959}},
+		{types.NewHmsRange(90000, 90000), 0, false, nil, []types.Hms{90000}},
+	} {
+		if act := x.r.Duration(); act != x.dur {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.r, x.dur, act)
+		}
+		if act := x.r.IsOvernight(); act != x.overnight {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.r, x.overnight, act)
+		}
+		for _, hms := range x.in {
+			if !x.r.Contains(hms) {
+				t.Errorf("%v: %v expected to be contained", x.r, hms)
+			}
+		}
+		for _, hms := range x.out {
+			if x.r.Contains(hms) {
+				t.Errorf("%v: %v expected not to be contained", x.r, hms)
+			}
+		}
+	}
+}
+
+func TestHmsRangeOverlaps(t *testing.T) {
+	for _, x := range []struct {
+		a, b types.HmsRange
+		exp  bool
+	}{
+		{types.NewHmsRange(90000, 170000), types.NewHmsRange(160000, 180000), true},
+		{types.NewHmsRange(90000, 170000), types.NewHmsRange(170000, 180000), false},
+		{types.NewHmsRange(220000, 60000), types.NewHmsRange(50000, 90000), true},
+		{types.NewHmsRange(220000, 60000), types.NewHmsRange(60000, 220000), false},
+		{types.NewHmsRange(220000, 300000), types.NewHmsRange(10000, 20000), true},
+		{types.NewHmsRange(230000, 10000), types.NewHmsRange(220000, 20000), true},
+	} {
+		if act := x.a.Overlaps(x.b); act != x.exp {
+			t.Errorf("%v, %v: expect(%v) != actual(%v)", x.a, x.b, x.exp, act)
+		}
+		if act := x.b.Overlaps(x.a); act != x.exp {
+			t.Errorf("%v, %v: expect(%v) != actual(%v)", x.b, x.a, x.exp, act)
+		}
+	}
+}
+
+func TestHmsRangeSplit(t *testing.T) {
+	for _, x := range []struct {
+		r   types.HmsRange
+		exp []types.HmsRange
+	}{
+		{types.NewHmsRange(90000, 170000), []types.HmsRange{{From: 90000, To: 170000}}},
+		{types.NewHmsRange(220000, 60000), []types.HmsRange{{From: 220000, To: 0}, {From: 0, To: 60000}}},
+		{types.NewHmsRange(220000, 253000), []types.HmsRange{{From: 220000, To: 0}, {From: 0, To: 13000}}},
+		{types.NewHmsRange(220000, 240000), []types.HmsRange{{From: 220000, To: 0}}},
+		{types.NewHmsRange(250000, 270000), []types.HmsRange{{From: 10000, To: 30000}}},
+	} {
+		if act := x.r.SplitAtMidnight(); !reflect.DeepEqual(act, x.exp) {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.r, x.exp, act)
+		}
+	}
+
+	r := types.NewHmsRange(220000, 253000).On(20240305)
+	if exp := (types.YmdhmsRange{From: 20240305220000, To: 20240306012959}); r != exp {
+		t.Errorf("expect(%v) != actual(%v)", exp, r)
+	}
+}
+
+func TestHmsExtended(t *testing.T) {
+	for _, x := range []struct {
+		s   string
+		exp types.Hms
+		err bool
+	}{
+		{"25:30", 253000, false},
+		{"47:59:59", 475959, false},
+		{"253000", 253000, false},
+		{"10:30", 103000, false},
+		{"48:00", 0, true},
+		{"25:60", 0, true},
+	} {
+		act, err := types.ParseHmsExtended(x.s)
+		if (err != nil) != x.err || (!x.err && act != x.exp) {
+			t.Errorf("%s: expect(%v) != actual(%v) %v", x.s, x.exp, act, err)
+		}
+	}
+	if _, err := types.ParseHms("25:30"); err == nil {
+		t.Errorf("error expected")
+	}
+
+	if act := types.Ymd(20240229).At(253000); act != 20240301013000 {
+		t.Errorf("expect(%v) != actual(%v)", 20240301013000, act)
+	}
+	if act := types.Ymd(20240305).At(103000); act != 20240305103000 {
+		t.Errorf("expect(%v) != actual(%v)", 20240305103000, act)
+	}
+	if act, err := types.Ymdhms(20240306013000).ExtendedHms(20240305); err != nil || act != 253000 {
+		t.Errorf("expect(%v) != actual(%v) %v", 253000, act, err)
+	}
+	if _, err := types.Ymdhms(20240307013000).ExtendedHms(20240305); err == nil {
+		t.Errorf("error expected")
+	}
+
+	var r types.HmsRange
+	if err := r.Scan("22:00～25:30"); err != nil || r != (types.HmsRange{From: 220000, To: 253000}) {
+		t.Errorf("%v %+v", r, err)
+	}
+	b, _ := json.Marshal(r)
+	var act types.HmsRange
+	if err := json.Unmarshal(b, &act); err != nil || act != r {
+		t.Errorf("expect(%v) != actual(%v) %v", r, act, err)
+	}
+}
@@ -0,0 +1,268 @@
+package types
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+type (
+	// Holiday 休日
+	Holiday struct {
+		Ymd  Ymd    `json:"ymd"`
+		Name string `json:"name"`
+	}
+
+	// HolidaySlice Holiday型のスライス
+	HolidaySlice []Holiday
+)
+
+func (h HolidaySlice) Len() int           { return len(h) }
+func (h HolidaySlice) Less(i, j int) bool { return h[i].Ymd < h[j].Ymd }
+func (h HolidaySlice) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+const (
+	holidaySubstitute = "振替休日"
+	holidayCitizens   = "国民の休日"
+)
+
+// 祝日法の施行日
+const holidayLawStart = Ymd(19480720)
+
+// 振替休日の施行日
+const substituteStart = Ymd(19730412)
+
+// 国民の休日の施行日
+const citizensStart = Ymd(19851227)
+
+// 振替休日・国民の休日の改正（2007年施行）
+const holidayLaw2007 = Ymd(20070101)
+
+// 法律で個別に定められた休日
+var _specialHolidays = []Holiday{
+	{19590410, "皇太子明仁親王の結婚の儀"},
+	{19890224, "昭和天皇の大喪の礼"},
+	{19901112, "即位礼正殿の儀"},
+	{19930609, "皇太子徳仁親王の結婚の儀"},
+	{20190501, "天皇の即位の日"},
+	{20191022, "即位礼正殿の儀"},
+}
+
+var _nationalHolidays sync.Map // map[int]HolidaySlice
+
+// Weekday 曜日を取得します
+func (ymd Ymd) Weekday() time.Weekday {
+	if ymd == 0 {
+		return time.Sunday
+	}
+	// グレゴリウス暦1年1月1日は月曜日
+	return time.Weekday((ymd.Days() + 1) % 7)
+}
+
+// NthWeekday 指定した月の第n曜日を取得します。該当する日がない場合は0を返します
+func NthWeekday(y, m, n int, wd time.Weekday) Ymd {
+	if n < 1 || m < 1 || m > 12 {
+		return 0
+	}
+	first := Ymd(y*10000 + m*100 + 1)
+	d := 1 + (int(wd)-int(first.Weekday())+7)%7 + (n-1)*7
+	if d > LastDay(y, m) {
+		return 0
+	}
+	return Ymd(y*10000 + m*100 + d)
+}
+
+// VernalEquinoxDay 春分日を取得します（1900～2099年）。範囲外の場合は0を返します
+func VernalEquinoxDay(y int) Ymd {
+	var d float64
+	switch {
+	case y >= 1900 && y <= 1979:
+		d = 20.8357 + 0.242194*float64(y-1980) - float64((y-1983)/4)
+	case y >= 1980 && y <= 2099:
+		d = 20.8431 + 0.242194*float64(y-1980) - float64((y-1980)/4)
+	default:
+		return 0
+	}
+	return Ymd(y*10000 + 300 + int(d))
+}
+
+// AutumnalEquinoxDay 秋分日を取得します（1900～2099年）。範囲外の場合は0を返します
+func AutumnalEquinoxDay(y int) Ymd {
+	var d float64
+	switch {
+	case y >= 1900 && y <= 1979:
+		d = 23.2588 + 0.242194*float64(y-1980) - float64((y-1983)/4)
+	case y >= 1980 && y <= 2099:
+		d = 23.2488 + 0.242194*float64(y-1980) - float64((y-1980)/4)
+	default:
+		return 0
+	}
+	return Ymd(y*10000 + 900 + int(d))
+}
+
+// 国民の祝日（振替休日・国民の休日を除く）を取得します
+func baseHolidays(y int) map[Ymd]string {
+	h := make(map[Ymd]string, 20)
+	add := func(ymd Ymd, name string) {
+		if ymd != 0 && ymd >= holidayLawStart {
+			h[ymd] = name
+		}
+	}
+	date := func(m, d int) Ymd {
+		return Ymd(y*10000 + m*100 + d)
+	}
+
+	add(date(1, 1), "元日")
+	if y < 2000 {
+		add(date(1, 15), "成人の日")
+	} else {
+		add(NthWeekday(y, 1, 2, time.Monday), "成人の日")
+	}
+	if y >= 1967 {
+		add(date(2, 11), "建国記念の日")
+	}
+	add(VernalEquinoxDay(y), "春分の日")
+	switch {
+	case y < 1989:
+		add(date(4, 29), "天皇誕生日")
+	case y < 2007:
+		add(date(4, 29), "みどりの日")
+	default:
+		add(date(4, 29), "昭和の日")
+		add(date(5, 4), "みどりの日")
+	}
+	add(date(5, 3), "憲法記念日")
+	add(date(5, 5), "こどもの日")
+	switch {
+	case y < 1996:
+	case y < 2003:
+		add(date(7, 20), "海の日")
+	case y == 2020:
+		add(date(7, 23), "海の日")
+	case y == 2021:
+		add(date(7, 22), "海の日")
+	default:
+		add(NthWeekday(y, 7, 3, time.Monday), "海の日")
+	}
+	switch {
+	case y < 2016:
+	case y == 2020:
+		add(date(8, 10), "山の日")
+	case y == 2021:
+		add(date(8, 8), "山の日")
+	default:
+		add(date(8, 11), "山の日")
+	}
+	switch {
+	case y < 1966:
+	case y < 2003:
+		add(date(9, 15), "敬老の日")
+	default:
+		add(NthWeekday(y, 9, 3, time.Monday), "敬老の日")
+	}
+	add(AutumnalEquinoxDay(y), "秋分の日")
+	switch {
+	case y < 1966:
+	case y < 2000:
+		add(date(10, 10), "体育の日")
+	case y < 2020:
+		add(NthWeekday(y, 10, 2, time.Monday), "体育の日")
+	case y == 2020:
+		add(date(7, 24), "スポーツの日")
+	case y == 2021:
+		add(date(7, 23), "スポーツの日")
+	default:
+		add(NthWeekday(y, 10, 2, time.Monday), "スポーツの日")
+	}
+	add(date(11, 3), "文化の日")
+	add(date(11, 23), "勤労感謝の日")
+	switch {
+	case y >= 1989 && y <= 2018:
+		add(date(12, 23), "天皇誕生日")
+	case y >= 2020:
+		add(date(2, 23), "天皇誕生日")
+	}
+	for _, x := range _specialHolidays {
+		if x.Ymd.Year() == y {
+			add(x.Ymd, x.Name)
+		}
+	}
+	return h
+}
+
+// JapaneseHolidays 指定した年の日本の休日（国民の祝日・振替休日・国民の休日）を日付順に取得します
+//
+//	春分日・秋分日は計算式で求めるため1900～2099年の範囲で有効です
+func JapaneseHolidays(y int) []Holiday {
+	return append([]Holiday(nil), japaneseHolidays(y)...)
+}
+
+func japaneseHolidays(y int) HolidaySlice {
+	if v, ok := _nationalHolidays.Load(y); ok {
+		return v.(HolidaySlice)
+	}
+
+	base := baseHolidays(y)
+	h := make(map[Ymd]string, len(base)+4)
+	keys := make(YmdSlice, 0, len(base))
+	for k, v := range base {
+		h[k] = v
+		keys = append(keys, k)
+	}
+	sort.Sort(keys)
+
+	// 振替休日
+	for _, ymd := range keys {
+		if ymd < substituteStart || ymd.Weekday() != time.Sunday {
+			continue
+		}
+		x := ymd.Next()
+		if ymd >= holidayLaw2007 {
+			for _, ok := h[x]; ok; _, ok = h[x] {
+				x = x.Next()
+			}
+		} else if _, ok := h[x]; ok {
+			continue
+		}
+		h[x] = holidaySubstitute
+	}
+
+	// 国民の休日
+	for _, ymd := range keys {
+		x := ymd.Next()
+		if x < citizensStart {
+			continue
+		}
+		if _, ok := base[x.Next()]; !ok {
+			continue
+		}
+		if _, ok := h[x]; ok {
+			continue
+		}
+		if x < holidayLaw2007 && x.Weekday() == time.Sunday {
+			continue
+		}
+		h[x] = holidayCitizens
+	}
+
+	v := make(HolidaySlice, 0, len(h))
+	for k, name := range h {
+		v = append(v, Holiday{Ymd: k, Name: name})
+	}
+	sort.Sort(v)
+	_nationalHolidays.Store(y, v)
+	return v
+}
+
+// JapaneseHoliday 日本の休日か判定して、休日の場合は名称を返します
+func JapaneseHoliday(ymd Ymd) (string, bool) {
+	if ymd == 0 {
+		return "", false
+	}
+	v := japaneseHolidays(ymd.Year())
+	i := sort.Search(len(v), func(i int) bool { return v[i].Ymd >= ymd })
+	if i < len(v) && v[i].Ymd == ymd {
+		return v[i].Name, true
+	}
+	return "", false
+}
@@ -0,0 +1,148 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestJapaneseHolidays(t *testing.T) {
+	for _, x := range []struct {
+		y   int
+		exp []types.Holiday
+	}{
+		{2019, []types.Holiday{
+			{Ymd: 20190101, Name: "元日"},
+			{Ymd: 20190114, Name: "成人の日"},
+			{Ymd: 20190211, Name: "建国記念の日"},
+			{Ymd: 20190321, Name: "春分の日"},
+			{Ymd: 20190429, Name: "昭和の日"},
+			{Ymd: 20190430, Name: "国民の休日"},
+			{Ymd: 20190501, Name: "天皇の即位の日"},
+			{Ymd: 20190502, Name: "国民の休日"},
+			{Ymd: 20190503, Name: "憲法記念日"},
+			{Ymd: 20190504, Name: "みどりの日"},
+			{Ymd: 20190505, Name: "こどもの日"},
+			{Ymd: 20190506, Name: "振替休日"},
+			{Ymd: 20190715, Name: "海の日"},
+			{Ymd: 20190811, Name: "山の日"},
+			{Ymd: 20190812, Name: "振替休日"},
+			{Ymd: 20190916, Name: "敬老の日"},
+			{Ymd: 20190923, Name: "秋分の日"},
+			{Ymd: 20191014, Name: "体育の日"},
+			{Ymd: 20191022, Name: "即位礼正殿の儀"},
+			{Ymd: 20191103, Name: "文化の日"},
+			{Ymd: 20191104, Name: "振替休日"},
+			{Ymd: 20191123, Name: "勤労感謝の日"},
+		}},
+		{2024, []types.Holiday{
+			{Ymd: 20240101, Name: "元日"},
+			{Ymd: 20240108, Name: "成人の日"},
+			{Ymd: 20240211, Name: "建国記念の日"},
+			{Ymd: 20240212, Name: "振替休日"},
+			{Ymd: 20240223, Name: "天皇誕生日"},
+			{Ymd: 20240320, Name: "春分の日"},
+			{Ymd: 20240429, Name: "昭和の日"},
+			{Ymd: 20240503, Name: "憲法記念日"},
+			{Ymd: 20240504, Name: "みどりの日"},
+			{Ymd: 20240505, Name: "こどもの日"},
+			{Ymd: 20240506, Name: "振替休日"},
+			{Ymd: 20240715, Name: "海の日"},
+			{Ymd: 20240811, Name: "山の日"},
+			{Ymd: 20240812, Name: "振替休日"},
+			{Ymd: 20240916, Name: "敬老の日"},
+			{Ymd: 20240922, Name: "秋分の日"},
+			{Ymd: 20240923, Name: "振替休日"},
+			{Ymd: 20241014, Name: "スポーツの日"},
+			{Ymd: 20241103, Name: "文化の日"},
+			{Ymd: 20241104, Name: "振替休日"},
+			{Ymd: 20241123, Name: "勤労感謝の日"},
+		}},
+	} {
+		act := types.JapaneseHolidays(x.y)
+		if len(act) != len(x.exp) {
+			t.Errorf("%d: length expect(%d) != actual(%d): %v", x.y, len(x.exp), len(act), act)
+			continue
+		}
+		for i := range act {
+			if act[i] != x.exp[i] {
+				t.Errorf("%d: expect(%v) != actual(%v)", x.y, x.exp[i], act[i])
+			}
+		}
+	}
+}
+
+func TestJapaneseHoliday(t *testing.T) {
+	for _, x := range []struct {
+		ymd  Ymd
+		name string
+		ok   bool
+	}{
+		{20090922, "国民の休日", true}, // シルバーウィーク
+		{20150922, "国民の休日", true},
+		{19880504, "国民の休日", true},
+		{20080506, "振替休日", true}, // 5/4(日)の振替が5/6になる
+		{20060504, "国民の休日", true},
+		{19730430, "振替休日", true},
+		{19730716, "", false},
+		{20200724, "スポーツの日", true},
+		{20211011, "", false},
+		{20190223, "", false},
+		{20240305, "", false},
+		{0, "", false},
+	} {
+		name, ok := types.JapaneseHoliday(x.ymd)
+		if name != x.name || ok != x.ok {
+			t.Errorf("%v: expect(%s,%v) != actual(%s,%v)", x.ymd, x.name, x.ok, name, ok)
+		}
+	}
+}
+
+func TestEquinoxDay(t *testing.T) {
+	for _, x := range []struct {
+		y              int
+		vernal, autumn Ymd
+	}{
+		{1978, 19780321, 19780923},
+		{2000, 20000320, 20000923},
+		{2012, 20120320, 20120922},
+		{2024, 20240320, 20240922},
+		{2025, 20250320, 20250923},
+		{2100, 0, 0},
+	} {
+		if act := types.VernalEquinoxDay(x.y); act != x.vernal {
+			t.Errorf("%d: expect(%v) != actual(%v)", x.y, x.vernal, act)
+		}
+		if act := types.AutumnalEquinoxDay(x.y); act != x.autumn {
+			t.Errorf("%d: expect(%v) != actual(%v)", x.y, x.autumn, act)
+		}
+	}
+}
+
+func TestYmdWeekday(t *testing.T) {
+	for ymd := Ymd(19700101); ymd <= 20301231; ymd = ymd.Next() {
+		if exp, act := ymd.GoTime().Weekday(), ymd.Weekday(); exp != act {
+			t.Errorf("%v: expect(%v) != actual(%v)", ymd, exp, act)
+			return
+		}
+	}
+}
+
+func TestNthWeekday(t *testing.T) {
+	for _, x := range []struct {
+		y, m, n int
+		wd      time.Weekday
+		exp     Ymd
+	}{
+		{2024, 1, 2, time.Monday, 20240108},
+		{2024, 9, 3, time.Monday, 20240916},
+		{2024, 3, 1, time.Friday, 20240301},
+		{2024, 3, 5, time.Sunday, 20240331},
+		{2024, 2, 5, time.Monday, 0},
+	} {
+		if act := types.NthWeekday(x.y, x.m, x.n, x.wd); act != x.exp {
+			t.Errorf("%d/%d #%d %v: expect(%v) != actual(%v)", x.y, x.m, x.n, x.wd, x.exp, act)
+		}
+	}
+}
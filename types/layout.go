@@ -0,0 +1,427 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MineTakaki/go-utils/errors"
+)
+
+type (
+	// DateParts レイアウト文字列で読み取った日時の要素
+	//
+	//	ParseLayoutの結果は各型のScanにそのまま渡すことができます
+	DateParts struct {
+		Year, Month, Day          int
+		Hour, Minute, Second      int
+		Millisecond               int
+		HasYear, HasMonth, HasDay bool
+		HasTime                   bool
+		weekday                   time.Weekday
+		hasWeekday                bool
+	}
+
+	layoutKind int
+
+	layoutToken struct {
+		kind  layoutKind
+		width int
+		text  string
+	}
+)
+
+const (
+	layoutLiteral layoutKind = iota
+	layoutYear
+	layoutMonth
+	layoutDay
+	layoutWeekday
+	layoutHour
+	layoutMinute
+	layoutSecond
+	layoutFraction
+)
+
+var _layoutKinds = map[byte]layoutKind{
+	'y': layoutYear,
+	'M': layoutMonth,
+	'd': layoutDay,
+	'E': layoutWeekday,
+	'H': layoutHour,
+	'm': layoutMinute,
+	's': layoutSecond,
+	'S': layoutFraction,
+}
+
+var _weekdayJa = []string{"日", "月", "火", "水", "木", "金", "土"}
+
+var _monthNames = []string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+
+var _weekdayNames = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+var _layoutCache sync.Map // map[string][]layoutToken
+
+// parseLayout レイアウト文字列を解析します
+//
+//	同じ文字の連続をひとつのフィールドとして扱い、'（シングルクォート）で囲んだ部分はそのまま出力します（''は'を出力します）
+func parseLayout(layout string) []layoutToken {
+	if v, ok := _layoutCache.Load(layout); ok {
+		return v.([]layoutToken)
+	}
+	var tokens []layoutToken
+	lit := strings.Builder{}
+	flush := func() {
+		if lit.Len() > 0 {
+			tokens = append(tokens, layoutToken{kind: layoutLiteral, text: lit.String()})
+			lit.Reset()
+		}
+	}
+	for i := 0; i < len(layout); {
+		c := layout[i]
+		if c == '\'' {
+			if i+1 < len(layout) && layout[i+1] == '\'' {
+				lit.WriteByte('\'')
+				i += 2
+				continue
+			}
+			j := i + 1
+			for j < len(layout) {
+				if layout[j] == '\'' {
+					if j+1 < len(layout) && layout[j+1] == '\'' {
+						lit.WriteByte('\'')
+						j += 2
+						continue
+					}
+					break
+				}
+				lit.WriteByte(layout[j])
+				j++
+			}
+			i = j + 1
+			continue
+		}
+		kind, ok := _layoutKinds[c]
+		if !ok {
+			lit.WriteByte(c)
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(layout) && layout[j] == c {
+			j++
+		}
+		flush()
+		tokens = append(tokens, layoutToken{kind: kind, width: j - i})
+		i = j
+	}
+	flush()
+	_layoutCache.Store(layout, tokens)
+	return tokens
+}
+
+func formatNumber(sb *strings.Builder, n, width int) {
+	if width <= 1 {
+		sb.WriteString(strconv.Itoa(n))
+		return
+	}
+	sb.WriteString(ZeroPrefix(strconv.Itoa(abs(n)), width))
+}
+
+// Format レイアウト文字列で整形します
+func (p DateParts) Format(layout string) string {
+	sb := strings.Builder{}
+	sb.Grow(len(layout) + 8)
+	for _, t := range parseLayout(layout) {
+		switch t.kind {
+		case layoutLiteral:
+			sb.WriteString(t.text)
+		case layoutYear:
+			switch t.width {
+			case 2:
+				formatNumber(&sb, p.Year%100, 2)
+			default:
+				formatNumber(&sb, p.Year, t.width)
+			}
+		case layoutMonth:
+			switch {
+			case t.width == 3 && p.Month >= 1 && p.Month <= 12:
+				sb.WriteString(_monthNames[p.Month-1][:3])
+			case t.width >= 4 && p.Month >= 1 && p.Month <= 12:
+				sb.WriteString(_monthNames[p.Month-1])
+			default:
+				formatNumber(&sb, p.Month, t.width)
+			}
+		case layoutDay:
+			formatNumber(&sb, p.Day, t.width)
+		case layoutWeekday:
+			wd, ok := p.Weekday()
+			if !ok {
+				continue
+			}
+			switch t.width {
+			case 1:
+				sb.WriteString(_weekdayJa[wd])
+			case 2:
+				sb.WriteString(_weekdayJa[wd] + "曜日")
+			case 3:
+				sb.WriteString(_weekdayNames[wd][:3])
+			default:
+				sb.WriteString(_weekdayNames[wd])
+			}
+		case layoutHour:
+			formatNumber(&sb, p.Hour, t.width)
+		case layoutMinute:
+			formatNumber(&sb, p.Minute, t.width)
+		case layoutSecond:
+			formatNumber(&sb, p.Second, t.width)
+		case layoutFraction:
+			sb.WriteString(formatFraction(p.Millisecond, t.width))
+		}
+	}
+	return sb.String()
+}
+
+// formatFraction ミリ秒を秒の小数部としてwidth桁で整形します（4桁目以降は0埋めします）
+func formatFraction(ms, width int) string {
+	f := ZeroPrefix(strconv.Itoa(abs(ms)%1000), 3)
+	if width <= 3 {
+		return f[:width]
+	}
+	return f + strings.Repeat("0", width-3)
+}
+
+// Weekday 曜日を取得します。年月日が揃っていない場合はokがfalseになります
+func (p DateParts) Weekday() (wd time.Weekday, ok bool) {
+	if p.hasWeekday {
+		return p.weekday, true
+	}
+	if !p.hasDate() {
+		return
+	}
+	return Ymd(p.Year*10000 + p.Month*100 + p.Day).Weekday(), true
+}
+
+func (p DateParts) hasDate() bool {
+	return p.HasYear && p.HasMonth && p.HasDay
+}
+
+// Ymd Ymd型に変換します
+func (p DateParts) Ymd() Ymd {
+	return Ymd(p.Year*10000 + p.Month*100 + p.Day)
+}
+
+// Ym Ym型に変換します
+func (p DateParts) Ym() Ym {
+	return Ym(p.Year*100 + p.Month)
+}
+
+// Md Md型に変換します
+func (p DateParts) Md() Md {
+	return Md(p.Month*100 + p.Day)
+}
+
+// Hms Hms型に変換します
+func (p DateParts) Hms() Hms {
+	return Hms(p.Hour*10000 + p.Minute*100 + p.Second)
+}
+
+// Ymdhms Ymdhms型に変換します
+func (p DateParts) Ymdhms() Ymdhms {
+	return Ymdhms(int64(p.Ymd())*1000000 + int64(p.Hms()))
+}
+
+// layoutReader レイアウトに従った文字列の読み取り
+type layoutReader struct {
+	s   string
+	src string
+}
+
+func (r *layoutReader) number(width, max int) (int, error) {
+	n := width
+	if width <= 1 {
+		n = 0
+		for n < max && n < len(r.s) && r.s[n] >= '0' && r.s[n] <= '9' {
+			n++
+		}
+		if n == 0 {
+			return 0, errors.Wrapf(ErrValidate, "number expected : '%s'", r.src)
+		}
+	} else if len(r.s) < n {
+		return 0, errors.Wrapf(ErrValidate, "too short text : '%s'", r.src)
+	}
+	v, err := strconv.Atoi(r.s[:n])
+	if err != nil || strings.ContainsAny(r.s[:n], "+-") {
+		return 0, errors.Wrapf(ErrValidate, "number expected : '%s'", r.src)
+	}
+	r.s = r.s[n:]
+	return v, nil
+}
+
+// name 候補の名前に一致するインデックスを取得します（大文字・小文字は区別しません）
+func (r *layoutReader) name(names []string, n int) (int, error) {
+	for i, name := range names {
+		if n > 0 && len(name) > n {
+			name = name[:n]
+		}
+		if len(r.s) >= len(name) && strings.EqualFold(r.s[:len(name)], name) {
+			r.s = r.s[len(name):]
+			return i, nil
+		}
+	}
+	return 0, errors.Wrapf(ErrValidate, "unknown name : '%s'", r.src)
+}
+
+// ParseLayout レイアウト文字列に従って日時を読み取ります
+//
+//	yy は2000年代として読み取ります。曜日を含む場合は日付と一致しているか確認します
+func ParseLayout(layout, s string) (p DateParts, err error) {
+	r := layoutReader{s: s, src: s}
+	for _, t := range parseLayout(layout) {
+		switch t.kind {
+		case layoutLiteral:
+			if !strings.HasPrefix(r.s, t.text) {
+				return p, errors.Wrapf(ErrValidate, "'%s' expected : '%s'", t.text, s)
+			}
+			r.s = r.s[len(t.text):]
+		case layoutYear:
+			if t.width == 2 {
+				if p.Year, err = r.number(2, 2); err != nil {
+					return
+				}
+				p.Year += 2000
+			} else if p.Year, err = r.number(t.width, 4); err != nil {
+				return
+			}
+			p.HasYear = true
+		case layoutMonth:
+			switch {
+			case t.width == 3:
+				p.Month, err = r.name(_monthNames, 3)
+				p.Month++
+			case t.width >= 4:
+				p.Month, err = r.name(_monthNames, 0)
+				p.Month++
+			default:
+				p.Month, err = r.number(t.width, 2)
+			}
+			if err != nil {
+				return
+			}
+			p.HasMonth = true
+		case layoutDay:
+			if p.Day, err = r.number(t.width, 2); err != nil {
+				return
+			}
+			p.HasDay = true
+		case layoutWeekday:
+			var wd int
+			switch t.width {
+			case 1:
+				wd, err = r.name(_weekdayJa, 0)
+			case 2:
+				if wd, err = r.name(_weekdayJa, 0); err == nil && strings.HasPrefix(r.s, "曜日") {
+					r.s = r.s[len("曜日"):]
+				}
+			case 3:
+				wd, err = r.name(_weekdayNames, 3)
+			default:
+				wd, err = r.name(_weekdayNames, 0)
+			}
+			if err != nil {
+				return
+			}
+			p.weekday, p.hasWeekday = time.Weekday(wd), true
+		case layoutHour:
+			if p.Hour, err = r.number(t.width, 2); err != nil {
+				return
+			}
+			p.HasTime = true
+		case layoutMinute:
+			if p.Minute, err = r.number(t.width, 2); err != nil {
+				return
+			}
+			p.HasTime = true
+		case layoutSecond:
+			if p.Second, err = r.number(t.width, 2); err != nil {
+				return
+			}
+			p.HasTime = true
+		case layoutFraction:
+			var f int
+			if f, err = r.number(t.width, t.width); err != nil {
+				return
+			}
+			for i := t.width; i < 3; i++ {
+				f *= 10
+			}
+			for i := t.width; i > 3; i-- {
+				f /= 10
+			}
+			p.Millisecond = f
+			p.HasTime = true
+		}
+	}
+	if r.s != "" {
+		return p, errors.Wrapf(ErrValidate, "extra text '%s' : '%s'", r.s, s)
+	}
+	if p.hasWeekday && p.hasDate() {
+		if wd := p.Ymd().Weekday(); wd != p.weekday {
+			return p, errors.Wrapf(ErrValidate, "weekday mismatch (%v) : '%s'", wd, s)
+		}
+	}
+	return p, nil
+}
+
+// Format レイアウト文字列で整形します
+//
+//	yyyy/yy/y: 年, MMMM/MMM/MM/M: 月, dd/d: 日,
+//	EEEE/EEE/EE/E: 曜日（Tuesday/Tue/火曜日/火）, HH/H: 時, mm/m: 分, ss/s: 秒,
+//	SSS/SS/S: 秒の小数部（ミリ秒、10ミリ秒、100ミリ秒単位）。
+//	1文字の場合は0埋めしません。'（シングルクォート）で囲んだ部分はそのまま出力します
+func (ymd Ymd) Format(layout string) string {
+	if ymd == 0 {
+		return ""
+	}
+	y, m, d := ymd.Part()
+	return DateParts{Year: y, Month: m, Day: d, HasYear: true, HasMonth: true, HasDay: true}.Format(layout)
+}
+
+// Format レイアウト文字列で整形します（書式はYmd.Formatを参照）
+func (ym Ym) Format(layout string) string {
+	if ym == 0 {
+		return ""
+	}
+	y, m := ym.Part()
+	return DateParts{Year: y, Month: m, HasYear: true, HasMonth: true}.Format(layout)
+}
+
+// Format レイアウト文字列で整形します（書式はYmd.Formatを参照）
+func (md Md) Format(layout string) string {
+	if md == 0 {
+		return ""
+	}
+	m, d := md.Part()
+	return DateParts{Month: m, Day: d, HasMonth: true, HasDay: true}.Format(layout)
+}
+
+// Format レイアウト文字列で整形します（書式はYmd.Formatを参照）
+//
+//	FormatHms, Value と同じく0は未設定として空文字を返します
+func (hms Hms) Format(layout string) string {
+	if hms == 0 {
+		return ""
+	}
+	h, m, s := hms.Part()
+	return DateParts{Hour: h, Minute: m, Second: s, HasTime: true}.Format(layout)
+}
+
+// Format レイアウト文字列で整形します（書式はYmd.Formatを参照）
+func (yh Ymdhms) Format(layout string) string {
+	if yh == 0 {
+		return ""
+	}
+	y, m, d, h, n, s := yh.Part()
+	return DateParts{Year: y, Month: m, Day: d, Hour: h, Minute: n, Second: s, HasYear: true, HasMonth: true, HasDay: true, HasTime: true}.Format(layout)
+}
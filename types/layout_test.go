@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestFormatLayout(t *testing.T) {
+	for _, x := range []struct {
+		act, exp string
+	}{
+		{Ymd(20240305).Format("yyyy/MM/dd(E)"), "2024/03/05(火)"},
+		{Ymd(20240305).Format("yyyy年M月d日 EE"), "2024年3月5日 火曜日"},
+		{Ymd(20240305).Format("EEE, d MMM yyyy"), "Tue, 5 Mar 2024"},
+		{Ymd(20240305).Format("EEEE, MMMM d, yy"), "Tuesday, March 5, 24"},
+		{Ymd(20240305).Format("yyyyMMdd"), "20240305"},
+		{Ymd(20240305).Format("'yyyy:'yyyy 'o''clock' ''"), "yyyy:2024 o'clock '"},
+		{Ymd(0).Format("yyyy/MM/dd"), ""},
+		{Ym(202403).Format("yyyy-MM"), "2024-03"},
+		{Ym(202403).Format("MMM yyyy(E)"), "Mar 2024()"},
+		{Md(1201).Format("M/d"), "12/1"},
+		{types.Hms(93005).Format("HH:mm:ss"), "09:30:05"},
+		{types.Hms(93005).Format("H時m分s秒"), "9時30分5秒"},
+		{types.Hms(0).Format("HH:mm"), ""},
+		{types.Ymdhms(20240305093005).Format("yyyy/MM/dd(E) HH:mm:ss"), "2024/03/05(火) 09:30:05"},
+	} {
+		if x.act != x.exp {
+			t.Errorf("expect(%s) != actual(%s)", x.exp, x.act)
+		}
+	}
+}
+
+func TestParseLayout(t *testing.T) {
+	for _, x := range []struct {
+		layout, s string
+		exp       types.Ymdhms
+		ok        bool
+	}{
+		{"yyyy/MM/dd(E) HH:mm:ss", "2024/03/05(火) 09:30:05", 20240305093005, true},
+		{"yyyy/MM/dd(E) HH:mm:ss", "2024/03/05(水) 09:30:05", 0, false},
+		{"yyyy年M月d日 H時m分s秒", "2024年3月5日 9時30分5秒", 20240305093005, true},
+		{"EEE, d MMM yyyy", "tue, 5 MAR 2024", 20240305000000, true},
+		{"EEEE, MMMM d, yy", "Tuesday, March 5, 24", 20240305000000, true},
+		{"yyyyMMddHHmmss", "20240305093005", 20240305093005, true},
+		{"yyyyMMdd", "2024035", 0, false},
+		{"yyyy/M/d", "2024/3/5x", 0, false},
+		{"yyyy/M/d", "2024-3-5", 0, false},
+		{"yyyy/MM/dd EE", "2024/03/05 火曜日", 20240305000000, true},
+	} {
+		p, err := types.ParseLayout(x.layout, x.s)
+		if x.ok && err != nil {
+			t.Errorf("%s: %+v", x.s, err)
+		} else if !x.ok && err == nil {
+			t.Errorf("%s: must be error", x.s)
+		} else if x.ok && p.Ymdhms() != x.exp {
+			t.Errorf("%s: expect(%v) != actual(%v)", x.s, x.exp, p.Ymdhms())
+		}
+	}
+}
+
+func TestParseLayoutScan(t *testing.T) {
+	const layout = "yyyy/MM/dd(E) HH:mm:ss"
+	yh := types.Ymdhms(20240305093005)
+	p, err := types.ParseLayout(layout, yh.Format(layout))
+	if err != nil {
+		t.Errorf("%+v", err)
+		return
+	}
+
+	var x types.Ymdhms
+	if err := x.Scan(p); err != nil || x != yh {
+		t.Errorf("Ymdhms: %v, %+v", x, err)
+	}
+	var ymd Ymd
+	if err := ymd.Scan(p); err != nil || ymd != 20240305 {
+		t.Errorf("Ymd: %v, %+v", ymd, err)
+	}
+	var ym Ym
+	if err := ym.Scan(p); err != nil || ym != 202403 {
+		t.Errorf("Ym: %v, %+v", ym, err)
+	}
+	var md Md
+	if err := md.Scan(p); err != nil || md != 305 {
+		t.Errorf("Md: %v, %+v", md, err)
+	}
+	var hms types.Hms
+	if err := hms.Scan(p); err != nil || hms != 93005 {
+		t.Errorf("Hms: %v, %+v", hms, err)
+	}
+}
@@ -0,0 +1,325 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/MineTakaki/go-utils/errors"
+)
+
+// normalizeWidth 全角の英数字・記号を半角に変換します
+func normalizeWidth(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '！' && r <= '～':
+			return r - '！' + '!'
+		case r == '　':
+			return ' '
+		case r == '−' || r == '‐' || r == '―':
+			return '-'
+		}
+		return r
+	}, s)
+}
+
+// stripWeekday 曜日の注記（"(火)", "（火曜日）", "(Tue)"）を取り除きます
+func stripWeekday(s string) (string, time.Weekday, bool) {
+	i := strings.IndexAny(s, "(（")
+	if i < 0 {
+		return s, 0, false
+	}
+	rest := s[i:]
+	_, n := utf8.DecodeRuneInString(rest)
+	rest = rest[n:]
+	j := strings.IndexAny(rest, ")）")
+	if j < 0 {
+		return s, 0, false
+	}
+	name := strings.TrimSpace(rest[:j])
+	_, n = utf8.DecodeRuneInString(rest[j:])
+	tail := rest[j+n:]
+	for wd, ja := range _weekdayJa {
+		if name == ja || name == ja+"曜" || name == ja+"曜日" ||
+			strings.EqualFold(name, _weekdayNames[wd]) || strings.EqualFold(name, _weekdayNames[wd][:3]) {
+			return s[:i] + " " + tail, time.Weekday(wd), true
+		}
+	}
+	return s, 0, false
+}
+
+// parseLenient 日付・時刻の文字列を寛容に読み取ります
+//
+//	"2024年3月5日", "２０２４/０３/０５", "3月5日(火)", "10:30", "10時30分", "2024.3.5",
+//	"2024-03-05T10:30:15" 等を受け付けます。
+//	年・月・日の並びが判断できない場合（"03/05/2024", "24/3/5"）はエラーになります
+func parseLenient(src string) (p DateParts, err error) {
+	s := normalizeWidth(src)
+	s, wd, hasWd := stripWeekday(s)
+
+	// 日付と時刻の区切りのTを空白に置き換えます
+	if i := strings.IndexByte(s, 'T'); i > 0 && i+1 < len(s) && isDigit(s[i-1]) && isDigit(s[i+1]) {
+		s = s[:i] + " " + s[i+1:]
+	}
+
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return p, errors.Wrapf(ErrValidate, "empty date text : '%s'", src)
+	}
+	for _, f := range fields {
+		switch {
+		case strings.ContainsAny(f, "年月日時分秒"):
+			err = p.lenientKanji(f, src)
+		case strings.Contains(f, ":"):
+			err = p.lenientTime(f, src)
+		default:
+			err = p.lenientDate(f, src)
+		}
+		if err != nil {
+			return
+		}
+	}
+	if hasWd {
+		if p.hasDate() {
+			if x := p.Ymd().Weekday(); x != wd {
+				return p, errors.Wrapf(ErrValidate, "weekday mismatch (%v) : '%s'", x, src)
+			}
+		}
+		p.weekday, p.hasWeekday = wd, true
+	}
+	return p, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// set 要素を設定します。同じ要素が複数ある場合はエラーにします
+func (p *DateParts) set(kind layoutKind, v int, src string) error {
+	var dst *int
+	var has *bool
+	switch kind {
+	case layoutYear:
+		dst, has = &p.Year, &p.HasYear
+	case layoutMonth:
+		dst, has = &p.Month, &p.HasMonth
+	case layoutDay:
+		dst, has = &p.Day, &p.HasDay
+	case layoutHour:
+		dst = &p.Hour
+	case layoutMinute:
+		dst = &p.Minute
+	case layoutSecond:
+		dst = &p.Second
+	}
+	if has != nil {
+		if *has {
+			return errors.Wrapf(ErrValidate, "duplicate date element : '%s'", src)
+		}
+		*has = true
+	} else {
+		p.HasTime = true
+	}
+	*dst = v
+	return nil
+}
+
+func (p *DateParts) lenientKanji(f, src string) error {
+	units := map[rune]layoutKind{
+		'年': layoutYear, '月': layoutMonth, '日': layoutDay,
+		'時': layoutHour, '分': layoutMinute, '秒': layoutSecond,
+	}
+	num := strings.Builder{}
+	for _, r := range f {
+		if r >= '0' && r <= '9' {
+			num.WriteRune(r)
+			continue
+		}
+		kind, ok := units[r]
+		if !ok {
+			return errors.Wrapf(ErrValidate, "unexpected character '%c' : '%s'", r, src)
+		}
+		if num.Len() == 0 {
+			return errors.Wrapf(ErrValidate, "number expected before '%c' : '%s'", r, src)
+		}
+		v, _ := strconv.Atoi(num.String())
+		num.Reset()
+		if kind == layoutYear && v < 100 {
+			return errors.Wrapf(ErrValidate, "ambiguous year %d : '%s'", v, src)
+		}
+		if err := p.set(kind, v, src); err != nil {
+			return err
+		}
+	}
+	if num.Len() > 0 {
+		return errors.Wrapf(ErrValidate, "unit expected after '%s' : '%s'", num.String(), src)
+	}
+	return nil
+}
+
+func (p *DateParts) lenientTime(f, src string) (err error) {
+	parts := strings.Split(f, ":")
+	if len(parts) > 3 {
+		return errors.Wrapf(ErrValidate, "incorrect time : '%s'", src)
+	}
+	if i := strings.IndexByte(parts[len(parts)-1], '.'); i >= 0 && len(parts) == 3 {
+		frac := parts[2][i+1:]
+		parts[2] = parts[2][:i]
+		if frac == "" || !isDigits(frac) {
+			return errors.Wrapf(ErrValidate, "incorrect fraction : '%s'", src)
+		}
+		frac = (frac + "00")[:3]
+		p.Millisecond, _ = strconv.Atoi(frac)
+	}
+	for i, kind := range []layoutKind{layoutHour, layoutMinute, layoutSecond}[:len(parts)] {
+		if parts[i] == "" || len(parts[i]) > 2 || !isDigits(parts[i]) {
+			return errors.Wrapf(ErrValidate, "incorrect time : '%s'", src)
+		}
+		v, _ := strconv.Atoi(parts[i])
+		if err = p.set(kind, v, src); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (p *DateParts) lenientDate(f, src string) (err error) {
+	parts := strings.FieldsFunc(f, func(r rune) bool { return r == '/' || r == '-' || r == '.' })
+	for _, x := range parts {
+		if !isDigits(x) {
+			return errors.Wrapf(ErrValidate, "incorrect date : '%s'", src)
+		}
+	}
+	var kinds []layoutKind
+	switch len(parts) {
+	case 1:
+		switch x := parts[0]; len(x) {
+		case 8:
+			parts = []string{x[:4], x[4:6], x[6:]}
+			kinds = []layoutKind{layoutYear, layoutMonth, layoutDay}
+		case 6:
+			parts = []string{x[:4], x[4:]}
+			kinds = []layoutKind{layoutYear, layoutMonth}
+		default:
+			return errors.Wrapf(ErrValidate, "ambiguous date : '%s'", src)
+		}
+	case 2:
+		switch {
+		case len(parts[0]) == 4 && len(parts[1]) <= 2:
+			kinds = []layoutKind{layoutYear, layoutMonth}
+		case len(parts[0]) <= 2 && len(parts[1]) <= 2:
+			kinds = []layoutKind{layoutMonth, layoutDay}
+		default:
+			return errors.Wrapf(ErrValidate, "ambiguous date order : '%s'", src)
+		}
+	case 3:
+		switch {
+		case len(parts[0]) == 4 && len(parts[1]) <= 2 && len(parts[2]) <= 2:
+			kinds = []layoutKind{layoutYear, layoutMonth, layoutDay}
+		case len(parts[2]) == 4:
+			return errors.Wrapf(ErrValidate, "ambiguous date order (month/day/year or day/month/year) : '%s'", src)
+		default:
+			return errors.Wrapf(ErrValidate, "ambiguous year : '%s'", src)
+		}
+	default:
+		return errors.Wrapf(ErrValidate, "incorrect date : '%s'", src)
+	}
+	for i, kind := range kinds {
+		v, _ := strconv.Atoi(parts[i])
+		if err = p.set(kind, v, src); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// lenientRequire 型ごとに必要な要素
+type lenientRequire int
+
+const (
+	lenientYmd lenientRequire = iota
+	lenientYm
+	lenientMd
+	lenientHms
+	lenientYmdhms
+)
+
+// lenientParts 寛容な読み取りを行い、型に必要な要素が揃っているか確認します
+func lenientParts(s string, req lenientRequire) (p DateParts, err error) {
+	if p, err = parseLenient(s); err != nil {
+		return
+	}
+	date := p.HasYear || p.HasMonth || p.HasDay
+	switch req {
+	case lenientHms:
+		if date {
+			return p, errors.Wrapf(ErrValidate, "unexpected date : '%s'", s)
+		}
+		if !p.HasTime {
+			return p, errors.Wrapf(ErrValidate, "time is missing : '%s'", s)
+		}
+		return
+	case lenientYmd, lenientYm, lenientMd:
+		if p.HasTime {
+			return p, errors.Wrapf(ErrValidate, "unexpected time : '%s'", s)
+		}
+	}
+	switch {
+	case !p.HasYear && req != lenientMd:
+		return p, errors.Wrapf(ErrValidate, "year is missing : '%s'", s)
+	case p.HasYear && req == lenientMd:
+		return p, errors.Wrapf(ErrValidate, "unexpected year : '%s'", s)
+	case !p.HasMonth:
+		return p, errors.Wrapf(ErrValidate, "month is missing : '%s'", s)
+	case !p.HasDay && req != lenientYm:
+		return p, errors.Wrapf(ErrValidate, "day is missing : '%s'", s)
+	case p.HasDay && req == lenientYm:
+		return p, errors.Wrapf(ErrValidate, "unexpected day : '%s'", s)
+	}
+	return
+}
+
+// validateCalendar 暦として正しい値か確認します
+//
+//	年の範囲は確認しません（ValidationPolicyによる確認は呼び出し元で行います）
+func (p DateParts) validateCalendar(src string) (err error) {
+	switch {
+	case p.HasYear && p.HasDay:
+		if p.Year < 1 || p.Month < 1 || p.Month > 12 || p.Day < 1 || p.Day > LastDay(p.Year, p.Month) {
+			err = errors.Wrapf(ErrValidate, "incorrect date value. y:%d, m:%d, d:%d", p.Year, p.Month, p.Day)
+		}
+	case p.HasDay:
+		_, err = ValidateMd(p.Month, p.Day)
+	case p.HasMonth:
+		_, err = ValidateMonth(p.Month)
+	}
+	if err == nil && p.HasTime {
+		_, err = ValidateHms(p.Hour, p.Minute, p.Second)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "'%s'", src)
+	}
+	return nil
+}
+
+// scanLenient 寛容な読み取りを行い、暦として正しい値か確認します
+func scanLenient[T Temporal[T]](s string, req lenientRequire, conv func(DateParts) T) (x T, err error) {
+	var p DateParts
+	if p, err = lenientParts(s, req); err != nil {
+		return
+	}
+	if err = p.validateCalendar(s); err != nil {
+		return
+	}
+	return conv(p), nil
+}
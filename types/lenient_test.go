@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/MineTakaki/go-utils/errors"
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestLenientScan(t *testing.T) {
+	for _, x := range []struct {
+		s   string
+		ymd types.Ymd
+		ym  types.Ym
+		md  types.Md
+		hms types.Hms
+		yh  types.Ymdhms
+	}{
+		{s: "2024年3月5日", ymd: 20240305, yh: 20240305000000},
+		{s: "２０２４/０３/０５", ymd: 20240305, yh: 20240305000000},
+		{s: "2024.3.5", ymd: 20240305, yh: 20240305000000},
+		{s: "2024年3月5日(火)", ymd: 20240305, yh: 20240305000000},
+		{s: "2024/3/5（火曜日）", ymd: 20240305, yh: 20240305000000},
+		{s: "3月5日(火)", md: 305},
+		{s: "３/５", md: 305},
+		{s: "2024年3月", ym: 202403},
+		{s: "２０２４．３", ym: 202403},
+		{s: "10:30", hms: 103000},
+		{s: "10時30分", hms: 103000},
+		{s: "１０：３０：１５", hms: 103015},
+		{s: "2024年3月5日 10時30分", yh: 20240305103000},
+		{s: "2024年3月5日10時30分15秒", yh: 20240305103015},
+		{s: "2024.3.5 10:30", yh: 20240305103000},
+		{s: "２０２４−０３−０５Ｔ１０：３０", yh: 20240305103000},
+	} {
+		var ymd types.Ymd
+		var ym types.Ym
+		var md types.Md
+		var hms types.Hms
+		var yh types.Ymdhms
+		if err := ymd.Scan(x.s); (err == nil) != (x.ymd != 0) || ymd != x.ymd {
+			t.Errorf("Ymd %s: expect(%v) != actual(%v) %v", x.s, x.ymd, ymd, err)
+		}
+		if err := ym.Scan(x.s); (err == nil) != (x.ym != 0) || ym != x.ym {
+			t.Errorf("Ym %s: expect(%v) != actual(%v) %v", x.s, x.ym, ym, err)
+		}
+		if err := md.Scan(x.s); (err == nil) != (x.md != 0) || md != x.md {
+			t.Errorf("Md %s: expect(%v) != actual(%v) %v", x.s, x.md, md, err)
+		}
+		if err := hms.Scan(x.s); (err == nil) != (x.hms != 0) || hms != x.hms {
+			t.Errorf("Hms %s: expect(%v) != actual(%v) %v", x.s, x.hms, hms, err)
+		}
+		if err := yh.Scan(x.s); (err == nil) != (x.yh != 0) || yh != x.yh {
+			t.Errorf("Ymdhms %s: expect(%v) != actual(%v) %v", x.s, x.yh, yh, err)
+		}
+	}
+
+	var ms types.YmdhmsMs
+	if err := ms.Scan("2024/3/5 10:30:15.12"); err != nil || ms != 20240305103015120 {
+		t.Errorf("expect(%v) != actual(%v) %v", 20240305103015120, ms, err)
+	}
+	var yw types.Yw
+	if err := yw.Scan("2024年3月5日"); err != nil || yw != 202410 {
+		t.Errorf("expect(%v) != actual(%v) %v", 202410, yw, err)
+	}
+}
+
+func TestLenientScanError(t *testing.T) {
+	for _, s := range []string{
+		"03/05/2024",     // 月/日/年か日/月/年か判断できない
+		"24/3/5",         // 2桁の年
+		"24年3月5日",        // 2桁の年
+		"2024年3月",        // 日がない
+		"2024/3/5(水)",    // 曜日が一致しない
+		"2024年3月5日3月",    // 要素が重複
+		"2024年2月30日",     // 存在しない日付
+		"2024/3/5 10:30", // Ymdに時刻
+		"2024年3月5日 25時",  // 不正な時刻
+		"abc",
+	} {
+		var ymd types.Ymd
+		var yh types.Ymdhms
+		if err := ymd.Scan(s); err == nil {
+			t.Errorf("%s: error expected (%v)", s, ymd)
+		} else if !errors.Is(err, types.ErrValidate) {
+			t.Errorf("%s: %+v", s, err)
+		}
+		if s == "2024/3/5 10:30" {
+			continue
+		}
+		if err := yh.Scan(s); err == nil {
+			t.Errorf("%s: error expected (%v)", s, yh)
+		}
+	}
+}
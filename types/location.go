@@ -0,0 +1,139 @@
+package types
+
+import (
+	"sync"
+	"time"
+)
+
+var _locationMu sync.RWMutex
+var _location *time.Location
+
+// DefaultLocation 日付型とtime.Timeの変換で使用するタイムゾーンを取得します（未設定の場合はtime.Local）
+func DefaultLocation() *time.Location {
+	_locationMu.RLock()
+	defer _locationMu.RUnlock()
+	if _location == nil {
+		return time.Local
+	}
+	return _location
+}
+
+// SetDefaultLocation 日付型とtime.Timeの変換で使用するタイムゾーンを設定します。nilの場合はtime.Localに戻します
+//
+//	YmdNow, YmdhmsNow, GoTime 等が対象になります
+func SetDefaultLocation(loc *time.Location) {
+	_locationMu.Lock()
+	_location = loc
+	_locationMu.Unlock()
+}
+
+func locationOrDefault(loc *time.Location) *time.Location {
+	if loc == nil {
+		return DefaultLocation()
+	}
+	return loc
+}
+
+// dateIn 指定したタイムゾーンの日時からtime.Timeを生成します
+//
+//	夏時間の切り替えで存在しない時刻（ギャップ）は切り替え前のオフセットで解釈します（02:30 → 03:30）。
+//	重複する時刻（オーバーラップ）は早い方（切り替え前）の時刻にします
+func dateIn(y, m, d, h, n, s int, loc *time.Location) time.Time {
+	utc := time.Date(y, time.Month(m), d, h, n, s, 0, time.UTC)
+	_, before := utc.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := utc.Add(24 * time.Hour).In(loc).Zone()
+
+	match := func(t time.Time) bool {
+		t = t.In(loc)
+		ty, tm, td := t.Date()
+		th, tn, ts := t.Clock()
+		return ty == utc.Year() && tm == utc.Month() && td == utc.Day() &&
+			th == utc.Hour() && tn == utc.Minute() && ts == utc.Second()
+	}
+
+	t1 := utc.Add(-time.Duration(before) * time.Second)
+	t2 := utc.Add(-time.Duration(after) * time.Second)
+	ok1, ok2 := match(t1), match(t2)
+	switch {
+	case ok1 && ok2:
+		if t2.Before(t1) {
+			return t2.In(loc)
+		}
+		return t1.In(loc)
+	case ok2:
+		return t2.In(loc)
+	}
+	// 一致しない場合（ギャップ）も切り替え前のオフセットで解釈します
+	return t1.In(loc)
+}
+
+// YmdNowIn 指定したタイムゾーンの現在の日付を取得します（nilの場合は既定のタイムゾーン）
+func YmdNowIn(loc *time.Location) Ymd {
+	t := time.Now().In(locationOrDefault(loc))
+	return Ymd(t.Year()*10000 + int(t.Month())*100 + t.Day())
+}
+
+// YmNow 現在の年月を取得します（既定のタイムゾーン）
+func YmNow() Ym {
+	return YmdNow().YearMonth()
+}
+
+// YmNowIn 指定したタイムゾーンの現在の年月を取得します（nilの場合は既定のタイムゾーン）
+func YmNowIn(loc *time.Location) Ym {
+	return YmdNowIn(loc).YearMonth()
+}
+
+// YmdhmsNowIn 指定したタイムゾーンの現在の日時を取得します（nilの場合は既定のタイムゾーン）
+func YmdhmsNowIn(loc *time.Location) Ymdhms {
+	return YmdhmsFromGoTime(time.Now().In(locationOrDefault(loc)))
+}
+
+// YmdFromGoTimeIn time.Timeを指定したタイムゾーンの日付に変換します（nilの場合は既定のタイムゾーン）
+func YmdFromGoTimeIn(tm time.Time, loc *time.Location) Ymd {
+	tm = tm.In(locationOrDefault(loc))
+	return Ymd(tm.Year()*10000 + int(tm.Month())*100 + tm.Day())
+}
+
+// YmdhmsFromGoTimeIn time.Timeを指定したタイムゾーンの日時に変換します（nilの場合は既定のタイムゾーン）
+func YmdhmsFromGoTimeIn(tm time.Time, loc *time.Location) Ymdhms {
+	return YmdhmsFromGoTime(tm.In(locationOrDefault(loc)))
+}
+
+// GoTimeIn 指定したタイムゾーンの0時のtime.Timeに変換します（nilの場合は既定のタイムゾーン）
+//
+//	夏時間の切り替え時の扱いはYmdhms.GoTimeInを参照してください
+func (ymd Ymd) GoTimeIn(loc *time.Location) (tm time.Time) {
+	if ymd != 0 {
+		y, m, d := ymd.Part()
+		y, m, d = AdjustDay(y, m, d)
+		tm = dateIn(y, m, d, 0, 0, 0, locationOrDefault(loc))
+	}
+	return
+}
+
+// GoTimeIn 指定したタイムゾーンの月初0時のtime.Timeに変換します（nilの場合は既定のタイムゾーン）
+func (ym Ym) GoTimeIn(loc *time.Location) (tm time.Time) {
+	if ym != 0 {
+		y, m := AdjustMonth(ym.Part())
+		tm = dateIn(y, m, 1, 0, 0, 0, locationOrDefault(loc))
+	}
+	return
+}
+
+// GoTimeIn 指定したタイムゾーンのtime.Timeに変換します（nilの場合は既定のタイムゾーン）
+//
+//	夏時間の切り替えで存在しない時刻は切り替え前のオフセットで解釈し（02:30 → 03:30）、
+//	重複する時刻は早い方（切り替え前）の時刻にします
+func (yh Ymdhms) GoTimeIn(loc *time.Location) (tm time.Time) {
+	if yh != 0 {
+		y, m, d, h, n, s := yh.Part()
+		h, n, s = AdjustHms(h, n, s)
+		if h > 23 {
+			d += h / 24
+			h %= 24
+		}
+		y, m, d = AdjustDay(y, m, d)
+		tm = dateIn(y, m, d, h, n, s, locationOrDefault(loc))
+	}
+	return
+}
@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return loc
+}
+
+func TestYmdhmsGoTimeIn(t *testing.T) {
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	newYork := mustLoadLocation(t, "America/New_York")
+
+	for _, x := range []struct {
+		yh      types.Ymdhms
+		loc     *time.Location
+		utc     string
+		local   types.Ymdhms
+		comment string
+	}{
+		{20240305100000, tokyo, "2024-03-05T01:00:00Z", 20240305100000, "通常"},
+		{20240310013000, newYork, "2024-03-10T06:30:00Z", 20240310013000, "夏時間開始の直前(EST)"},
+		{20240310023000, newYork, "2024-03-10T07:30:00Z", 20240310033000, "存在しない時刻は切り替え前のオフセットで解釈"},
+		{20240310033000, newYork, "2024-03-10T07:30:00Z", 20240310033000, "夏時間開始の直後(EDT)"},
+		{20241103013000, newYork, "2024-11-03T05:30:00Z", 20241103013000, "重複する時刻は早い方(EDT)"},
+		{20241103023000, newYork, "2024-11-03T07:30:00Z", 20241103023000, "夏時間終了後(EST)"},
+	} {
+		tm := x.yh.GoTimeIn(x.loc)
+		if act := tm.UTC().Format(time.RFC3339); act != x.utc {
+			t.Errorf("%v(%s): expect(%s) != actual(%s): %s", x.yh, x.loc, x.utc, act, x.comment)
+		}
+		if act := types.YmdhmsFromGoTimeIn(tm, x.loc); act != x.local {
+			t.Errorf("%v(%s): expect(%v) != actual(%v): %s", x.yh, x.loc, x.local, act, x.comment)
+		}
+	}
+}
+
+func TestYmdGoTimeIn(t *testing.T) {
+	saoPaulo := mustLoadLocation(t, "America/Sao_Paulo")
+
+	// 2018/11/04は0時に夏時間が始まったため0時が存在しない
+	tm := Ymd(20181104).GoTimeIn(saoPaulo)
+	if act := tm.UTC().Format(time.RFC3339); act != "2018-11-04T03:00:00Z" {
+		t.Errorf("expect(2018-11-04T03:00:00Z) != actual(%s)", act)
+	}
+	if act := types.YmdFromGoTimeIn(tm, saoPaulo); act != 20181104 {
+		t.Errorf("expect(20181104) != actual(%v)", act)
+	}
+
+	tm = Ym(202403).GoTimeIn(time.UTC)
+	if act := tm.Format(time.RFC3339); act != "2024-03-01T00:00:00Z" {
+		t.Errorf("expect(2024-03-01T00:00:00Z) != actual(%s)", act)
+	}
+}
+
+func TestDefaultLocation(t *testing.T) {
+	defer types.SetDefaultLocation(nil)
+
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	types.SetDefaultLocation(tokyo)
+
+	if loc := types.DefaultLocation(); loc != tokyo {
+		t.Errorf("DefaultLocation: %v", loc)
+	}
+	if loc := Ymd(20240305).GoTime().Location(); loc != tokyo {
+		t.Errorf("Ymd.GoTime: %v", loc)
+	}
+	if loc := Ym(202403).GoTime().Location(); loc != tokyo {
+		t.Errorf("Ym.GoTime: %v", loc)
+	}
+	if loc := types.Ymdhms(20240305100000).GoTime().Location(); loc != tokyo {
+		t.Errorf("Ymdhms.GoTime: %v", loc)
+	}
+
+	utc := time.Date(2024, 3, 5, 20, 0, 0, 0, time.UTC)
+	if act := types.YmdhmsFromGoTimeIn(utc, nil); act != 20240306050000 {
+		t.Errorf("YmdhmsFromGoTimeIn: %v", act)
+	}
+	if act := types.YmdhmsFromGoTime(utc); act != 20240305200000 {
+		t.Errorf("YmdhmsFromGoTime: %v", act)
+	}
+
+	now := time.Now()
+	if act, exp := types.YmdNow(), types.YmdFromGoTimeIn(now, tokyo); act != exp && act != exp.Next() {
+		t.Errorf("YmdNow: expect(%v) != actual(%v)", exp, act)
+	}
+	if act, exp := types.YmdNowIn(time.UTC), types.YmdFromGoTimeIn(now, time.UTC); act != exp && act != exp.Next() {
+		t.Errorf("YmdNowIn: expect(%v) != actual(%v)", exp, act)
+	}
+
+	types.SetDefaultLocation(nil)
+	if loc := types.DefaultLocation(); loc != time.Local {
+		t.Errorf("DefaultLocation: %v", loc)
+	}
+}
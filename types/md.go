@@ -17,13 +17,9 @@ type (
 	Md int
 
 	//MdSlice Md型のスライス
-	MdSlice []Md
+	MdSlice = Slice[Md]
 )
 
-func (md MdSlice) Len() int           { return len(md) }
-func (md MdSlice) Less(i, j int) bool { return md[i] < md[j] }
-func (md MdSlice) Swap(i, j int)      { md[i], md[j] = md[j], md[i] }
-
 // ToMd 月日からMd型に変換します
 func ToMd(m, d int) (md Md, err error) {
 	_, err = ValidateMd(m, d)
@@ -36,11 +32,7 @@ func ToMd(m, d int) (md Md, err error) {
 
 // ParseMd Md型に変換します
 func ParseMd(i interface{}) (md Md, err error) {
-	err = md.Scan(i)
-	if err == nil {
-		_, err = md.Validate()
-	}
-	return
+	return ParseMdWith(nil, i)
 }
 
 // ParseMd2 Md型に変換します
@@ -103,25 +95,22 @@ func (md *Md) UnmarshalJSON(b []byte) (err error) {
 	return
 }
 
-// MarshalJSON json.Marshalerの実装
-func (md *Md) MarshalJSON() ([]byte, error) {
-	return json.Marshal(int(*md))
+// MarshalJSON json.Marshalerの実装（表現はSetJSONFormatで変更できます）
+func (md Md) MarshalJSON() ([]byte, error) {
+	return marshalJSON(md, JSONNumber, md.ISOString)
 }
 
-// MarshalLogObject zapcore.ObjectMarshalerの実装
-func (md *Md) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+// MarshalLogObject zapcore.ObjectMarshalerの実装（{"md":"0305"}）
+//
+//	LogValueと同じ文字列の形式で出力する場合はzapx.Mdを使用します
+func (md Md) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("md", md.String())
 	return nil
 }
 
 // Validate 年月が正しいか確認します
 func (md Md) Validate() (bool, error) {
-	if md == 0 {
-		return true, nil
-	}
-
-	m, d := md.Part()
-	return ValidateMd(m, d)
+	return md.ValidateWith(nil)
 }
 
 // Month 月を取得します
@@ -258,19 +247,38 @@ func (md Md) Adjust() Md {
 }
 
 // Scan 文字列から月日を読み取ります
+//
+//	書式に一致しない文字列は寛容に読み取ります（"3月5日(火)", "３/５" 等）
 func (md *Md) Scan(i interface{}) (err error) {
 	if conv.IsEmpty(i) {
 		*md = 0
 		return nil
 	}
+	if p, ok := i.(DateParts); ok {
+		*md = p.Md()
+		return nil
+	}
 	if n, ok := conv.Int(i); ok {
 		*md = Md(n)
 		return
 	}
+	if s, ok := i.(string); ok {
+		for _, layout := range []string{"--MM-dd", "MM-dd", "MM/dd"} {
+			if p, err := ParseLayout(layout, s); err == nil {
+				*md = p.Md()
+				return nil
+			}
+		}
+		var x Md
+		if x, err = scanLenient(s, lenientMd, DateParts.Md); err == nil {
+			*md = x
+		}
+		return
+	}
 	return errors.WithStack(ErrValidate)
 }
 
-// Between 二つの日付の間に入るか判定します
+// Between 二つの月日の間（両端を含む）に入るか判定します。f > t の場合は年をまたぐ範囲として扱います
 func (md Md) Between(f, t Md) bool {
 	if md == 0 || f == 0 || t == 0 {
 		return false
@@ -278,7 +286,7 @@ func (md Md) Between(f, t Md) bool {
 	if f > t {
 		return md >= f || md <= t
 	}
-	return f <= md && md >= t
+	return f <= md && md <= t
 }
 
 // Compare Md同志を比較します
@@ -113,3 +113,21 @@ func TestMdCompare(t *testing.T) {
 		}
 	}
 }
+
+func TestMdBetween(t *testing.T) {
+	for _, x := range []struct {
+		md, f, t Md
+		exp      bool
+	}{
+		{501, 401, 630, true},
+		{301, 401, 630, false},
+		{701, 401, 630, false},
+		{1231, 1201, 228, true},
+		{115, 1201, 228, true},
+		{601, 1201, 228, false},
+	} {
+		if act := x.md.Between(x.f, x.t); act != x.exp {
+			t.Errorf("%v.Between(%v, %v): expect(%v) != actual(%v)", x.md, x.f, x.t, x.exp, act)
+		}
+	}
+}
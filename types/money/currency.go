@@ -0,0 +1,97 @@
+package money
+
+import (
+	goerr "errors"
+	"strings"
+	"sync"
+
+	"github.com/MineTakaki/go-utils/errors"
+)
+
+// Currency ISO 4217の通貨コード
+type Currency string
+
+const (
+	// JPY 日本円
+	JPY Currency = "JPY"
+	// USD 米ドル
+	USD Currency = "USD"
+	// EUR ユーロ
+	EUR Currency = "EUR"
+)
+
+// ErrCurrency 通貨が登録されていない
+var ErrCurrency = goerr.New("unknown currency")
+
+// ErrCurrencyMismatch 異なる通貨の金額を演算しようとした
+var ErrCurrencyMismatch = goerr.New("currency mismatch")
+
+// ErrAllocate 配分の比率・分割数が正しくない
+var ErrAllocate = goerr.New("incorrect allocation")
+
+var _currencyMu sync.RWMutex
+
+// 通貨ごとの小数点以下の桁数（補助単位の桁数）
+var _currencyScales = map[Currency]int32{
+	JPY: 0, USD: 2, EUR: 2, "GBP": 2, "CNY": 2, "KRW": 0, "TWD": 2, "HKD": 2, "SGD": 2, "AUD": 2, "CAD": 2, "CHF": 2,
+}
+
+var _defaultCurrency = JPY
+
+// RegisterCurrency 通貨と小数点以下の桁数を登録します（登録済みの場合は桁数を置き換えます）
+func RegisterCurrency(c Currency, scale int32) {
+	_currencyMu.Lock()
+	_currencyScales[Currency(strings.ToUpper(string(c)))] = scale
+	_currencyMu.Unlock()
+}
+
+// DefaultCurrency 通貨の指定がない値を読み取る際の通貨を取得します
+func DefaultCurrency() Currency {
+	_currencyMu.RLock()
+	defer _currencyMu.RUnlock()
+	return _defaultCurrency
+}
+
+// SetDefaultCurrency 通貨の指定がない値を読み取る際の通貨を設定します
+func SetDefaultCurrency(c Currency) (err error) {
+	if c, err = ParseCurrency(string(c)); err != nil {
+		return
+	}
+	_currencyMu.Lock()
+	_defaultCurrency = c
+	_currencyMu.Unlock()
+	return nil
+}
+
+// ParseCurrency 通貨コードを読み取ります（大文字・小文字は区別しません）
+func ParseCurrency(s string) (Currency, error) {
+	c := Currency(strings.ToUpper(strings.TrimSpace(s)))
+	if _, ok := c.scale(); !ok {
+		return "", errors.Wrapf(ErrCurrency, "'%s'", s)
+	}
+	return c, nil
+}
+
+func (c Currency) scale() (int32, bool) {
+	_currencyMu.RLock()
+	defer _currencyMu.RUnlock()
+	n, ok := _currencyScales[c]
+	return n, ok
+}
+
+// Scale 小数点以下の桁数を取得します（登録されていない通貨の場合は0）
+func (c Currency) Scale() int32 {
+	n, _ := c.scale()
+	return n
+}
+
+// IsValid 登録された通貨か判定します
+func (c Currency) IsValid() bool {
+	_, ok := c.scale()
+	return ok
+}
+
+// String string型変換
+func (c Currency) String() string {
+	return string(c)
+}
@@ -0,0 +1,355 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/MineTakaki/go-utils/conv"
+	"github.com/MineTakaki/go-utils/errors"
+	"github.com/MineTakaki/go-utils/types/decimal"
+	"go.uber.org/zap/zapcore"
+)
+
+// Money 通貨付きの金額
+//
+//	金額は常に通貨の桁数（JPYは0桁、USDは2桁 等）に丸めた値で保持します。
+//	異なる通貨の金額同士の演算はErrCurrencyMismatchのエラーになります。
+//	ゼロ値は通貨が未設定の0として扱い、任意の通貨の金額と演算できます
+type Money struct {
+	amount   decimal.Decimal
+	currency Currency
+}
+
+// New 金額と通貨からMoney型を生成します（通貨の桁数に四捨五入します）
+func New(amount decimal.Decimal, c Currency) (m Money, err error) {
+	if c, err = ParseCurrency(string(c)); err != nil {
+		return
+	}
+	return Money{amount: amount.Round(c.Scale()), currency: c}, nil
+}
+
+// Must 金額と通貨からMoney型を生成します（エラーの場合はpanicします）
+func Must(amount decimal.Decimal, c Currency) Money {
+	m, err := New(amount, c)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// FromMinor 補助単位（セント 等）の整数値からMoney型を生成します
+func FromMinor(n int64, c Currency) (m Money, err error) {
+	if c, err = ParseCurrency(string(c)); err != nil {
+		return
+	}
+	return Money{amount: decimal.New(n, -c.Scale()), currency: c}, nil
+}
+
+// Parse 文字列（"JPY 1000", "12.34 USD", 通貨なしの場合は既定の通貨）を読み取ります
+func Parse(s string) (m Money, err error) {
+	err = m.Scan(s)
+	return
+}
+
+// Amount 金額を取得します
+func (m Money) Amount() decimal.Decimal {
+	return m.amount
+}
+
+// Currency 通貨を取得します
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+// Minor 補助単位の整数値を取得します
+func (m Money) Minor() int64 {
+	return m.amount.Shift(m.currency.Scale()).IntPart()
+}
+
+// String string型変換（"JPY 1000", "USD 12.30"）
+func (m Money) String() string {
+	if m.currency == "" {
+		return m.amount.String()
+	}
+	return string(m.currency) + " " + m.amountString()
+}
+
+// amountString 金額を通貨の桁数でstring型に変換します（"12.30"）
+func (m Money) amountString() string {
+	if m.currency == "" {
+		return m.amount.String()
+	}
+	return m.amount.StringFixed(m.currency.Scale())
+}
+
+// IsZero 金額が0か判定します
+func (m Money) IsZero() bool {
+	return m.amount.IsZero()
+}
+
+// Sign 金額の符号を取得します（-1, 0, 1）
+func (m Money) Sign() int {
+	return m.amount.Sign()
+}
+
+// IsNegative 金額が負の値か判定します
+func (m Money) IsNegative() bool {
+	return m.amount.IsNegative()
+}
+
+// IsPositive 金額が正の値か判定します
+func (m Money) IsPositive() bool {
+	return m.amount.IsPositive()
+}
+
+// currencyOf 演算結果の通貨を取得します
+func (m Money) currencyOf(o Money) (Currency, error) {
+	switch {
+	case m.currency == o.currency || o.currency == "":
+		return m.currency, nil
+	case m.currency == "":
+		return o.currency, nil
+	}
+	return "", errors.Wrapf(ErrCurrencyMismatch, "%s, %s", m.currency, o.currency)
+}
+
+// Add 加算します
+func (m Money) Add(o Money) (Money, error) {
+	c, err := m.currencyOf(o)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: m.amount.Add(o.amount), currency: c}, nil
+}
+
+// Sub 減算します
+func (m Money) Sub(o Money) (Money, error) {
+	return m.Add(o.Neg())
+}
+
+// Neg 符号を反転します
+func (m Money) Neg() Money {
+	return Money{amount: m.amount.Neg(), currency: m.currency}
+}
+
+// Abs 絶対値を取得します
+func (m Money) Abs() Money {
+	return Money{amount: m.amount.Abs(), currency: m.currency}
+}
+
+// Mul 係数を乗算して通貨の桁数に四捨五入します
+func (m Money) Mul(f decimal.Decimal) Money {
+	return Money{amount: m.amount.Mul(f).Round(m.currency.Scale()), currency: m.currency}
+}
+
+// Cmp 比較します（m < o: -1, m == o: 0, m > o: 1）
+func (m Money) Cmp(o Money) (int, error) {
+	if _, err := m.currencyOf(o); err != nil {
+		return 0, err
+	}
+	return m.amount.Cmp(o.amount), nil
+}
+
+// Equal 通貨と金額が等しいか判定します
+func (m Money) Equal(o Money) bool {
+	c, err := m.Cmp(o)
+	return err == nil && c == 0
+}
+
+// Sum 合計を取得します
+func Sum(v ...Money) (sum Money, err error) {
+	for _, m := range v {
+		if sum, err = sum.Add(m); err != nil {
+			return Money{}, err
+		}
+	}
+	return
+}
+
+// Allocate 比率に従って金額を配分します
+//
+//	配分は補助単位で行い、端数は切り捨てた際の余りが大きい順に1単位ずつ加えるため、
+//	配分した金額の合計は常に元の金額と一致します（余りが同じ場合は先の要素を優先します）
+func (m Money) Allocate(ratios ...int) ([]Money, error) {
+	if len(ratios) == 0 {
+		return nil, errors.Wrap(ErrAllocate, "no ratios")
+	}
+	total := int64(0)
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, errors.Wrapf(ErrAllocate, "negative ratio : %v", ratios)
+		}
+		if total > math.MaxInt64-int64(r) {
+			return nil, errors.Wrapf(ErrAllocate, "sum of ratios overflows : %v", ratios)
+		}
+		total += int64(r)
+	}
+	if total == 0 {
+		return nil, errors.Wrapf(ErrAllocate, "ratios sum to zero : %v", ratios)
+	}
+
+	scale := m.currency.Scale()
+	units := m.amount.Abs().Shift(scale)
+	sum := decimal.NewFromInt(total)
+	parts := make([]decimal.Decimal, len(ratios))
+	rems := make([]decimal.Decimal, len(ratios))
+	rest := units
+	for i, r := range ratios {
+		parts[i], rems[i] = units.Mul(decimal.NewFromInt(int64(r))).QuoRem(sum, 0)
+		rest = rest.Sub(parts[i])
+	}
+	idx := make([]int, len(ratios))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return rems[idx[i]].GreaterThan(rems[idx[j]]) })
+	for i := 0; rest.IsPositive(); i++ {
+		parts[idx[i%len(idx)]] = parts[idx[i%len(idx)]].Add(decimal.One)
+		rest = rest.Sub(decimal.One)
+	}
+
+	v := make([]Money, len(ratios))
+	for i, p := range parts {
+		p = p.Shift(-scale)
+		if m.amount.IsNegative() {
+			p = p.Neg()
+		}
+		v[i] = Money{amount: p, currency: m.currency}
+	}
+	return v, nil
+}
+
+// Split 金額をn等分します（端数は先の要素に1単位ずつ加えます）
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, errors.Wrapf(ErrAllocate, "incorrect count : %d", n)
+	}
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
+
+// Scan 金額を読み取ります
+//
+//	"JPY 1000", "1000 JPY", "USD12.34" 等の通貨付きの文字列のほか、数値は既定の通貨の金額として読み取ります
+func (m *Money) Scan(i interface{}) (err error) {
+	if conv.IsEmpty(i) {
+		*m = Money{}
+		return nil
+	}
+	c := DefaultCurrency()
+	var s string
+	switch v := i.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		var d decimal.Decimal
+		if err = d.Scan(i); err != nil {
+			return
+		}
+		var x Money
+		if x, err = New(d, c); err == nil {
+			*m = x
+		}
+		return
+	}
+	s = strings.TrimSpace(s)
+	if n := len(s); n > 3 && isAlpha(s[:3]) {
+		c, s = Currency(s[:3]), s[3:]
+	} else if n > 3 && isAlpha(s[n-3:]) {
+		c, s = Currency(s[n-3:]), s[:n-3]
+	}
+	var d decimal.Decimal
+	if d, err = decimal.NewFromString(strings.TrimSpace(s)); err != nil {
+		return errors.Wrapf(decimal.ErrScan, "'%s'", i)
+	}
+	var x Money
+	if x, err = New(d, c); err == nil {
+		*m = x
+	}
+	return
+}
+
+func isAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i] | 0x20; c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// Value driver.Valuerインターフェイスの実装（"JPY 1000" 形式の文字列）
+func (m Money) Value() (driver.Value, error) {
+	if m.currency == "" {
+		return nil, nil
+	}
+	return m.String(), nil
+}
+
+type moneyJSON struct {
+	Amount   decimal.Decimal `json:"amount"`
+	Currency Currency        `json:"currency"`
+}
+
+// MarshalJSON json.Marshalerの実装（{"amount":"12.30","currency":"USD"}）
+//
+//	金額は通貨の桁数で出力します。decimal.MarshalJSONWithoutQuotesがtrueの場合は数値で出力します。
+//	通貨が未設定の場合はValueと同様にnullを出力します
+func (m Money) MarshalJSON() ([]byte, error) {
+	if m.currency == "" {
+		return []byte("null"), nil
+	}
+	amount := m.amountString()
+	var a interface{} = amount
+	if decimal.MarshalJSONWithoutQuotes {
+		a = json.Number(amount)
+	}
+	return json.Marshal(struct {
+		Amount   interface{} `json:"amount"`
+		Currency Currency    `json:"currency"`
+	}{a, m.currency})
+}
+
+// UnmarshalJSON json.Unmarshalerインターフェイスの実装（オブジェクトまたは文字列）
+//
+//	nullと通貨が未設定の0（{"amount":"0","currency":""}）はゼロ値になります
+func (m *Money) UnmarshalJSON(b []byte) (err error) {
+	if s := strings.TrimSpace(string(b)); s == "null" {
+		*m = Money{}
+		return nil
+	} else if strings.HasPrefix(s, `"`) {
+		var x string
+		if err = errors.WithStack(json.Unmarshal(b, &x)); err != nil {
+			return
+		}
+		return m.Scan(x)
+	}
+	var x moneyJSON
+	if err = errors.WithStack(json.Unmarshal(b, &x)); err != nil {
+		return
+	}
+	if x.Currency == "" && x.Amount.IsZero() {
+		*m = Money{}
+		return nil
+	}
+	var v Money
+	if v, err = New(x.Amount, x.Currency); err == nil {
+		*m = v
+	}
+	return
+}
+
+// MarshalLogObject zapcore.ObjectMarshalerの実装
+func (m Money) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("amount", m.amountString())
+	enc.AddString("currency", string(m.currency))
+	return nil
+}
@@ -0,0 +1,296 @@
+package money_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/MineTakaki/go-utils/errors"
+	"github.com/MineTakaki/go-utils/types/decimal"
+	"github.com/MineTakaki/go-utils/types/money"
+	"go.uber.org/zap/zapcore"
+)
+
+func mustParse(t *testing.T, s string) money.Money {
+	t.Helper()
+	m, err := money.Parse(s)
+	if err != nil {
+		t.Fatalf("%s: %+v", s, err)
+	}
+	return m
+}
+
+func TestNew(t *testing.T) {
+	for _, tc := range []struct {
+		amount   string
+		currency money.Currency
+		expect   string
+		err      bool
+	}{
+		{"1000", money.JPY, "JPY 1000", false},
+		{"1000.5", money.JPY, "JPY 1001", false},
+		{"12.345", money.USD, "USD 12.35", false},
+		{"12", "usd", "USD 12.00", false},
+		{"-0.005", money.EUR, "EUR -0.01", false},
+		{"1", "XXX", "", true},
+		{"1", "", "", true},
+	} {
+		m, err := money.New(decimal.RequireFromString(tc.amount), tc.currency)
+		if tc.err {
+			if !errors.Is(err, money.ErrCurrency) {
+				t.Errorf("%s %s: expect ErrCurrency, actual %v", tc.amount, tc.currency, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s %s: %+v", tc.amount, tc.currency, err)
+		} else if m.String() != tc.expect {
+			t.Errorf("expect(%v) != actual(%v)", tc.expect, m)
+		}
+	}
+}
+
+func TestFromMinor(t *testing.T) {
+	m, err := money.FromMinor(1234, money.USD)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if s := m.String(); s != "USD 12.34" {
+		t.Errorf("expect(%v) != actual(%v)", "USD 12.34", s)
+	}
+	if n := m.Minor(); n != 1234 {
+		t.Errorf("expect(%v) != actual(%v)", 1234, n)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	a := mustParse(t, "USD 10.25")
+	b := mustParse(t, "USD 0.75")
+	if m, err := a.Add(b); err != nil {
+		t.Errorf("%+v", err)
+	} else if !m.Equal(mustParse(t, "USD 11")) {
+		t.Errorf("expect(%v) != actual(%v)", "USD 11.00", m)
+	}
+	if m, err := b.Sub(a); err != nil {
+		t.Errorf("%+v", err)
+	} else if !m.Equal(mustParse(t, "USD -9.5")) {
+		t.Errorf("expect(%v) != actual(%v)", "USD -9.50", m)
+	}
+	if m := a.Mul(decimal.RequireFromString("0.1")); !m.Equal(mustParse(t, "USD 1.03")) {
+		t.Errorf("expect(%v) != actual(%v)", "USD 1.03", m)
+	}
+	if m, err := (money.Money{}).Add(a); err != nil || !m.Equal(a) {
+		t.Errorf("expect(%v) != actual(%v) %v", a, m, err)
+	}
+	if m, err := money.Sum(a, b, a); err != nil || !m.Equal(mustParse(t, "USD 21.25")) {
+		t.Errorf("expect(%v) != actual(%v) %v", "USD 21.25", m, err)
+	}
+
+	jpy := mustParse(t, "JPY 100")
+	if _, err := a.Add(jpy); !errors.Is(err, money.ErrCurrencyMismatch) {
+		t.Errorf("Add: expect ErrCurrencyMismatch, actual %v", err)
+	}
+	if _, err := a.Sub(jpy); !errors.Is(err, money.ErrCurrencyMismatch) {
+		t.Errorf("Sub: expect ErrCurrencyMismatch, actual %v", err)
+	}
+	if _, err := a.Cmp(jpy); !errors.Is(err, money.ErrCurrencyMismatch) {
+		t.Errorf("Cmp: expect ErrCurrencyMismatch, actual %v", err)
+	}
+	if _, err := money.Sum(a, jpy); !errors.Is(err, money.ErrCurrencyMismatch) {
+		t.Errorf("Sum: expect ErrCurrencyMismatch, actual %v", err)
+	}
+	if a.Equal(mustParse(t, "EUR 10.25")) {
+		t.Errorf("Equal: different currencies must not be equal")
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	for _, tc := range []struct {
+		amount string
+		ratios []int
+		expect []string
+	}{
+		{"JPY 100", []int{1, 1, 1}, []string{"JPY 34", "JPY 33", "JPY 33"}},
+		{"JPY 1000", []int{3, 7}, []string{"JPY 300", "JPY 700"}},
+		{"USD 0.05", []int{3, 7}, []string{"USD 0.02", "USD 0.03"}},
+		{"USD 100", []int{1, 2, 0}, []string{"USD 33.33", "USD 66.67", "USD 0.00"}},
+		{"JPY -100", []int{1, 1, 1}, []string{"JPY -34", "JPY -33", "JPY -33"}},
+		{"JPY 2", []int{1, 1, 1}, []string{"JPY 1", "JPY 1", "JPY 0"}},
+		{"JPY 10", []int{1, 3, 3}, []string{"JPY 2", "JPY 4", "JPY 4"}},
+	} {
+		m := mustParse(t, tc.amount)
+		v, err := m.Allocate(tc.ratios...)
+		if err != nil {
+			t.Errorf("%s %v: %+v", tc.amount, tc.ratios, err)
+			continue
+		}
+		if len(v) != len(tc.expect) {
+			t.Errorf("%s %v: expect(%v) != actual(%v)", tc.amount, tc.ratios, tc.expect, v)
+			continue
+		}
+		for i, x := range v {
+			if x.String() != tc.expect[i] {
+				t.Errorf("%s %v [%d]: expect(%v) != actual(%v)", tc.amount, tc.ratios, i, tc.expect[i], x)
+			}
+		}
+		if sum, err := money.Sum(v...); err != nil || !sum.Equal(m) {
+			t.Errorf("%s %v: sum expect(%v) != actual(%v)", tc.amount, tc.ratios, m, sum)
+		}
+	}
+
+	m := mustParse(t, "JPY 100")
+	for _, ratios := range [][]int{nil, {0, 0}, {1, -1}, {math.MaxInt, 1}} {
+		if _, err := m.Allocate(ratios...); !errors.Is(err, money.ErrAllocate) {
+			t.Errorf("%v: expect ErrAllocate, actual %v", ratios, err)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	m := mustParse(t, "USD 10")
+	v, err := m.Split(3)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expect := []string{"USD 3.34", "USD 3.33", "USD 3.33"}
+	for i, x := range v {
+		if x.String() != expect[i] {
+			t.Errorf("[%d]: expect(%v) != actual(%v)", i, expect[i], x)
+		}
+	}
+	if _, err := m.Split(0); !errors.Is(err, money.ErrAllocate) {
+		t.Errorf("expect ErrAllocate, actual %v", err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	for _, tc := range []struct {
+		src    interface{}
+		expect string
+		err    bool
+	}{
+		{"JPY 1000", "JPY 1000", false},
+		{"12.3 USD", "USD 12.30", false},
+		{"usd12.345", "USD 12.35", false},
+		{[]byte("EUR 5"), "EUR 5.00", false},
+		{"1500", "JPY 1500", false},
+		{int64(800), "JPY 800", false},
+		{"ABC 1", "", true},
+		{"JPY abc", "", true},
+	} {
+		var m money.Money
+		err := m.Scan(tc.src)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%v: expect error, actual %v", tc.src, m)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: %+v", tc.src, err)
+		} else if m.String() != tc.expect {
+			t.Errorf("expect(%v) != actual(%v)", tc.expect, m)
+		}
+	}
+
+	var m money.Money
+	if err := m.Scan(nil); err != nil || m.Currency() != "" || !m.IsZero() {
+		t.Errorf("nil: expect zero value, actual %v %v", m, err)
+	}
+}
+
+func TestValue(t *testing.T) {
+	m := mustParse(t, "USD 1.5")
+	v, err := m.Value()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if v != "USD 1.50" {
+		t.Errorf("expect(%v) != actual(%v)", "USD 1.50", v)
+	}
+	var x money.Money
+	if err = x.Scan(v); err != nil || !x.Equal(m) {
+		t.Errorf("expect(%v) != actual(%v) %v", m, x, err)
+	}
+	if v, _ := (money.Money{}).Value(); v != nil {
+		t.Errorf("expect(nil) != actual(%v)", v)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	m := mustParse(t, "USD 12.3")
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if s := string(b); s != `{"amount":"12.30","currency":"USD"}` {
+		t.Errorf("expect(%v) != actual(%v)", `{"amount":"12.30","currency":"USD"}`, s)
+	}
+	if b, _ = json.Marshal(mustParse(t, "JPY 100")); string(b) != `{"amount":"100","currency":"JPY"}` {
+		t.Errorf("expect(%v) != actual(%v)", `{"amount":"100","currency":"JPY"}`, string(b))
+	}
+	var rt money.Money
+	if err = json.Unmarshal(b, &rt); err != nil || !rt.Equal(mustParse(t, "JPY 100")) {
+		t.Errorf("expect(%v) != actual(%v) %v", "JPY 100", rt, err)
+	}
+
+	for _, tc := range []struct {
+		src    string
+		expect string
+		err    bool
+	}{
+		{`{"amount":"12.3","currency":"USD"}`, "USD 12.30", false},
+		{`{"amount":100.4,"currency":"jpy"}`, "JPY 100", false},
+		{`"EUR 7.5"`, "EUR 7.50", false},
+		{`{"amount":"1","currency":"XXX"}`, "", true},
+		{`null`, "0", false},
+		{`{"amount":"0","currency":""}`, "0", false},
+		{`{"amount":"1","currency":""}`, "", true},
+	} {
+		var x money.Money
+		err := json.Unmarshal([]byte(tc.src), &x)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%s: expect error, actual %v", tc.src, x)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %+v", tc.src, err)
+		} else if x.String() != tc.expect {
+			t.Errorf("expect(%v) != actual(%v)", tc.expect, x)
+		}
+	}
+
+	// 未設定のフィールドも読み戻せます
+	type rec struct {
+		Price money.Money `json:"price"`
+		Tax   money.Money `json:"tax"`
+	}
+	src := rec{Price: mustParse(t, "USD 12.30")}
+	if b, err = json.Marshal(src); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if s := string(b); s != `{"price":{"amount":"12.30","currency":"USD"},"tax":null}` {
+		t.Errorf("expect(%v) != actual(%v)", `{"price":{"amount":"12.30","currency":"USD"},"tax":null}`, s)
+	}
+	var act rec
+	if err = json.Unmarshal(b, &act); err != nil {
+		t.Errorf("%+v", err)
+	} else if !act.Price.Equal(src.Price) || act.Tax != (money.Money{}) {
+		t.Errorf("expect(%v) != actual(%v)", src, act)
+	}
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+	if err := mustParse(t, "USD 12.3").MarshalLogObject(enc); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if act := enc.Fields["amount"]; act != "12.30" {
+		t.Errorf("expect(%v) != actual(%v)", "12.30", act)
+	}
+	if act := enc.Fields["currency"]; act != "USD" {
+		t.Errorf("expect(%v) != actual(%v)", "USD", act)
+	}
+}
@@ -0,0 +1,13 @@
+//go:build go1.21
+
+package money
+
+import "log/slog"
+
+// LogValue slog.LogValuerの実装
+func (m Money) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("amount", m.amountString()),
+		slog.String("currency", string(m.currency)),
+	)
+}
@@ -0,0 +1,19 @@
+//go:build go1.21
+
+package money_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLogValue(t *testing.T) {
+	buf := bytes.Buffer{}
+	log := slog.New(slog.NewJSONHandler(&buf, nil))
+	log.Info("test", "price", mustParse(t, "USD 12.3"))
+	if exp := `"price":{"amount":"12.30","currency":"USD"}`; !strings.Contains(buf.String(), exp) {
+		t.Errorf("expect(%v) != actual(%v)", exp, buf.String())
+	}
+}
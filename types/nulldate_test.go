@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestNullYmd(t *testing.T) {
+	var n types.NullYmd
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("%v %+v", n, err)
+	}
+	if err := n.Scan(int64(0)); err != nil || !n.Valid || n.Ymd != 0 {
+		t.Errorf("%v %+v", n, err)
+	}
+	if v, _ := n.Value(); v != int64(0) {
+		t.Errorf("expect(0) != actual(%v)", v)
+	}
+	if v, _ := (types.NullYmd{}).Value(); v != nil {
+		t.Errorf("expect(nil) != actual(%v)", v)
+	}
+
+	var x struct {
+		A types.NullYmd `json:"a"`
+		B types.NullYmd `json:"b"`
+		C types.NullYmd `json:"c"`
+	}
+	if err := json.Unmarshal([]byte(`{"a":null,"b":0,"c":20240305}`), &x); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if x.A.Valid || !x.B.Valid || x.B.Ymd != 0 || !x.C.Valid || x.C.Ymd != 20240305 {
+		t.Errorf("%+v", x)
+	}
+	b, err := json.Marshal(x)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if exp := `{"a":null,"b":0,"c":20240305}`; string(b) != exp {
+		t.Errorf("expect(%s) != actual(%s)", exp, b)
+	}
+
+	if act := types.Ymd(20240131).Nullable().Add(0, 1, 0); act != types.Ymd(20240229).Nullable() {
+		t.Errorf("expect(%v) != actual(%v)", 20240229, act)
+	}
+	if act := (types.NullYmd{}).Add(0, 1, 0); act.Valid {
+		t.Errorf("expect(null) != actual(%v)", act)
+	}
+}
+
+func TestNullCmp(t *testing.T) {
+	null := types.NullYm{}
+	zero := types.Ym(0).Nullable()
+	a := types.Ym(202401).Nullable()
+	b := types.Ym(202402).Nullable()
+	for _, x := range []struct {
+		a, b    types.NullYm
+		cmp     int
+		cmpNz   int
+		equal   bool
+		comment string
+	}{
+		{null, null, 0, 0, true, "null-null"},
+		{null, zero, -1, 0, false, "null-zero"},
+		{zero, null, 1, 0, false, "zero-null"},
+		{null, a, -1, -1, false, "null-value"},
+		{a, b, -1, -1, false, "value-value"},
+		{b, a, 1, 1, false, "value-value"},
+		{a, a, 0, 0, true, "same"},
+	} {
+		if act := x.a.Cmp(x.b); act != x.cmp {
+			t.Errorf("%s Cmp: expect(%v) != actual(%v)", x.comment, x.cmp, act)
+		}
+		if act := x.a.CmpNz(x.b); act != x.cmpNz {
+			t.Errorf("%s CmpNz: expect(%v) != actual(%v)", x.comment, x.cmpNz, act)
+		}
+		if act := x.a.Equal(x.b); act != x.equal {
+			t.Errorf("%s Equal: expect(%v) != actual(%v)", x.comment, x.equal, act)
+		}
+	}
+	if act := null.Min(a).Min(b); act != a {
+		t.Errorf("expect(%v) != actual(%v)", a, act)
+	}
+	if act := zero.Max(b).Max(a); act != b {
+		t.Errorf("expect(%v) != actual(%v)", b, act)
+	}
+}
+
+func TestNullHmsYmdhms(t *testing.T) {
+	h := types.Hms(0).Nullable()
+	if v, _ := h.Value(); v != int64(0) {
+		t.Errorf("expect(0) != actual(%v)", v)
+	}
+	var yh types.NullYmdhms
+	if err := json.Unmarshal([]byte(`"2024-03-05 10:30:15"`), &yh); err != nil || yh.Ymdhms != 20240305103015 {
+		t.Errorf("%v %+v", yh, err)
+	}
+	if act := yh.Add(0, 0, 0, 0, 30, 0).Ymdhms; act != 20240305110015 {
+		t.Errorf("expect(%v) != actual(%v)", 20240305110015, act)
+	}
+	if p := (types.NullYmdhms{}).Ptr(); p != nil {
+		t.Errorf("expect(nil) != actual(%v)", *p)
+	}
+}
@@ -0,0 +1,258 @@
+package types
+
+import (
+	"sync"
+
+	"github.com/MineTakaki/go-utils/errors"
+)
+
+// ValidationPolicy 日付型の妥当性確認の条件
+//
+//	年の範囲（MinYear～MaxYear）と、範囲外でも許可する特殊値（Sentinels）を指定します。
+//	特殊値は年月日で指定し、Ymdhms では日付部分が一致する値（99991231hhmmss）を許可します。
+//	Ym・Md の特殊値は年月日から導出せず、SentinelYms・SentinelMds に指定した値のみ許可します。
+//	設定後のフィールドの変更は想定していません
+type ValidationPolicy struct {
+	MinYear     int
+	MaxYear     int
+	Sentinels   []Ymd
+	SentinelYms []Ym
+	SentinelMds []Md
+}
+
+var _policyMu sync.RWMutex
+var _policy = NewValidationPolicy(1998, 2999, 99991231, 99999999)
+
+// NewValidationPolicy 年の範囲と特殊値を指定して妥当性確認の条件を生成します
+func NewValidationPolicy(minYear, maxYear int, sentinels ...Ymd) *ValidationPolicy {
+	return &ValidationPolicy{MinYear: minYear, MaxYear: maxYear, Sentinels: sentinels}
+}
+
+// DefaultValidationPolicy Validate, Parse* で使用する妥当性確認の条件を取得します
+//
+//	初期値は1998～2999年、特殊値 99991231, 99999999 です
+func DefaultValidationPolicy() *ValidationPolicy {
+	_policyMu.RLock()
+	defer _policyMu.RUnlock()
+	return _policy
+}
+
+// SetDefaultValidationPolicy Validate, Parse* で使用する妥当性確認の条件を設定します。nilの場合は初期値に戻します
+func SetDefaultValidationPolicy(p *ValidationPolicy) {
+	if p == nil {
+		p = NewValidationPolicy(1998, 2999, 99991231, 99999999)
+	}
+	_policyMu.Lock()
+	_policy = p
+	_policyMu.Unlock()
+}
+
+var _calendarOnlyPolicy = NewValidationPolicy(1, 9999)
+
+// CalendarOnlyPolicy 年の範囲を限定しない（暦として正しい日付であれば許可する）妥当性確認の条件を取得します
+//
+//	既定の条件（1998年以降）より前の日付も扱う場合に使用します
+func CalendarOnlyPolicy() *ValidationPolicy {
+	return _calendarOnlyPolicy
+}
+
+func policyOrDefault(p *ValidationPolicy) *ValidationPolicy {
+	if p == nil {
+		return DefaultValidationPolicy()
+	}
+	return p
+}
+
+// IsSentinel 特殊値として許可された年月日か判定します
+func (p *ValidationPolicy) IsSentinel(ymd Ymd) bool {
+	for _, x := range policyOrDefault(p).Sentinels {
+		if x == ymd {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *ValidationPolicy) isSentinelYm(ym Ym) bool {
+	for _, x := range policyOrDefault(p).SentinelYms {
+		if x == ym {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *ValidationPolicy) isSentinelMd(md Md) bool {
+	for _, x := range policyOrDefault(p).SentinelMds {
+		if x == md {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateYear 年が有効か確認します
+func (p *ValidationPolicy) ValidateYear(y int) (bool, error) {
+	p = policyOrDefault(p)
+	if y >= p.MinYear && y <= p.MaxYear {
+		return true, nil
+	}
+	return false, errors.Wrapf(ErrValidate, "%d is not correct as a year value", y)
+}
+
+// ValidateYm 年月が有効か確認します
+func (p *ValidationPolicy) ValidateYm(y, m int) (ok bool, err error) {
+	if p.isSentinelYm(Ym(y*100 + m)) {
+		return true, nil
+	}
+	if ok, err = p.ValidateYear(y); err != nil {
+		return
+	}
+	return ValidateMonth(m)
+}
+
+// ValidateMd 月日が有効か確認します（うるう年の考慮はできません）
+func (p *ValidationPolicy) ValidateMd(m, d int) (bool, error) {
+	if p.isSentinelMd(Md(m*100 + d)) {
+		return true, nil
+	}
+	return ValidateMd(m, d)
+}
+
+// ValidateYmd 年月日が有効か確認します
+func (p *ValidationPolicy) ValidateYmd(y, m, d int) (bool, error) {
+	if p.IsSentinel(Ymd(y*10000 + m*100 + d)) {
+		return true, nil
+	}
+	if _, err := p.ValidateYear(y); err == nil && m >= 1 && m <= 12 && d >= 1 {
+		if lday := LastDay(y, m); d <= lday {
+			return true, nil
+		}
+	}
+	return false, errors.Wrapf(ErrValidate, "incorrect date value. y:%d, m:%d, d:%d", y, m, d)
+}
+
+// ValidateYmdhms 年月日時分秒が有効か確認します
+func (p *ValidationPolicy) ValidateYmdhms(y, m, d, h, n, s int) (bool, error) {
+	if _, err := p.ValidateYmd(y, m, d); err == nil {
+		if _, err = ValidateHms(h, n, s); err == nil {
+			return true, nil
+		}
+	}
+	return false, errors.Wrapf(ErrValidate, "incorrect date value. y:%d, m:%d, d:%d, h:%d, n:%d, s:%d", y, m, d, h, n, s)
+}
+
+// ValidateYw 年週が有効か確認します
+func (p *ValidationPolicy) ValidateYw(y, w int) (ok bool, err error) {
+	if ok, err = p.ValidateYear(y); err != nil {
+		return
+	}
+	if w < 1 || w > WeeksInYear(y) {
+		return false, errors.Wrapf(ErrValidate, "%d is not correct as a week value of %d", w, y)
+	}
+	return true, nil
+}
+
+// ValidateWith 指定した条件で年月日が正しいか確認します（nilの場合は既定の条件）
+func (ymd Ymd) ValidateWith(p *ValidationPolicy) (bool, error) {
+	if ymd == 0 {
+		return true, nil
+	}
+	y, m, d := ymd.Part()
+	return p.ValidateYmd(y, m, d)
+}
+
+// ValidateWith 指定した条件で年月が正しいか確認します（nilの場合は既定の条件）
+func (ym Ym) ValidateWith(p *ValidationPolicy) (bool, error) {
+	if ym == 0 {
+		return true, nil
+	}
+	y, m := ym.Part()
+	return p.ValidateYm(y, m)
+}
+
+// ValidateWith 指定した条件で月日が正しいか確認します（nilの場合は既定の条件）
+func (md Md) ValidateWith(p *ValidationPolicy) (bool, error) {
+	if md == 0 {
+		return true, nil
+	}
+	m, d := md.Part()
+	return p.ValidateMd(m, d)
+}
+
+// ValidateWith 指定した条件で年月日時分秒が正しいか確認します（nilの場合は既定の条件）
+func (yh Ymdhms) ValidateWith(p *ValidationPolicy) (bool, error) {
+	if yh == 0 {
+		return true, nil
+	}
+	y, m, d, h, n, s := yh.Part()
+	return p.ValidateYmdhms(y, m, d, h, n, s)
+}
+
+// ValidateWith 指定した条件で年月日時分秒ミリ秒が正しいか確認します（nilの場合は既定の条件）
+func (x YmdhmsMs) ValidateWith(p *ValidationPolicy) (bool, error) {
+	return x.Ymdhms().ValidateWith(p)
+}
+
+// ValidateWith 指定した条件で年週が正しいか確認します（nilの場合は既定の条件）
+func (yw Yw) ValidateWith(p *ValidationPolicy) (bool, error) {
+	if yw == 0 {
+		return true, nil
+	}
+	y, w := yw.Part()
+	return p.ValidateYw(y, w)
+}
+
+// ParseYmdWith 指定した条件でYmd型に変換します（nilの場合は既定の条件）
+func ParseYmdWith(p *ValidationPolicy, i interface{}) (ymd Ymd, err error) {
+	if err = ymd.Scan(i); err != nil {
+		return
+	}
+	_, err = ymd.ValidateWith(p)
+	return
+}
+
+// ParseYmWith 指定した条件でYm型に変換します（nilの場合は既定の条件）
+func ParseYmWith(p *ValidationPolicy, i interface{}) (ym Ym, err error) {
+	if err = ym.Scan(i); err != nil {
+		return
+	}
+	_, err = ym.ValidateWith(p)
+	return
+}
+
+// ParseMdWith 指定した条件でMd型に変換します（nilの場合は既定の条件）
+func ParseMdWith(p *ValidationPolicy, i interface{}) (md Md, err error) {
+	if err = md.Scan(i); err != nil {
+		return
+	}
+	_, err = md.ValidateWith(p)
+	return
+}
+
+// ParseYmdhmsWith 指定した条件でYmdhms型に変換します（nilの場合は既定の条件）
+func ParseYmdhmsWith(p *ValidationPolicy, i interface{}) (yh Ymdhms, err error) {
+	if err = yh.Scan(i); err != nil {
+		return
+	}
+	_, err = yh.ValidateWith(p)
+	return
+}
+
+// ParseYmdhmsMsWith 指定した条件でYmdhmsMs型に変換します（nilの場合は既定の条件）
+func ParseYmdhmsMsWith(p *ValidationPolicy, i interface{}) (x YmdhmsMs, err error) {
+	if err = x.Scan(i); err != nil {
+		return
+	}
+	_, err = x.ValidateWith(p)
+	return
+}
+
+// ParseYwWith 指定した条件でYw型に変換します（nilの場合は既定の条件）
+func ParseYwWith(p *ValidationPolicy, i interface{}) (yw Yw, err error) {
+	if err = yw.Scan(i); err != nil {
+		return
+	}
+	_, err = yw.ValidateWith(p)
+	return
+}
@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestValidationPolicy(t *testing.T) {
+	// 既定の条件は従来どおり
+	for _, x := range []struct {
+		v  interface{}
+		ok bool
+	}{
+		{"20240229", true},
+		{"19970101", false},
+		{"99991231", true},
+		{"99999999", true},
+		{"99990101", false},
+	} {
+		if _, err := types.ParseYmd(x.v); (err == nil) != x.ok {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.v, x.ok, err)
+		}
+	}
+
+	p := types.NewValidationPolicy(1900, 2100, 21001231)
+	if _, err := types.ParseYmdWith(p, "19650315"); err != nil {
+		t.Errorf("%+v", err)
+	}
+	if _, err := types.ParseYmdWith(p, "99991231"); err == nil {
+		t.Errorf("error expected")
+	}
+	// 寛容な読み取りでも年の範囲は指定した条件で確認します
+	for _, v := range []string{"1965年3月15日", "1965.3.15", "１９６５／０３／１５", "1965-03-15"} {
+		if act, err := types.ParseYmdWith(p, v); err != nil {
+			t.Errorf("%s: %+v", v, err)
+		} else if act != 19650315 {
+			t.Errorf("%s: expect(%v) != actual(%v)", v, 19650315, act)
+		}
+		if _, err := types.ParseYmd(v); err == nil {
+			t.Errorf("%s: error expected", v)
+		}
+	}
+	if _, err := types.ParseYmdWith(p, "1965年2月29日"); err == nil {
+		t.Errorf("error expected")
+	}
+	if _, err := types.ParseYmdhmsWith(p, "1965年3月15日 10時00分"); err != nil {
+		t.Errorf("%+v", err)
+	}
+	if _, err := types.ParseYmWith(p, 190001); err != nil {
+		t.Errorf("%+v", err)
+	}
+	if _, err := types.ParseYmdhmsWith(p, "1965-03-15 10:00:00"); err != nil {
+		t.Errorf("%+v", err)
+	}
+	if _, err := types.ParseYmdhmsWith(p, "1965-03-15 24:00:00"); err == nil {
+		t.Errorf("error expected")
+	}
+	if _, err := types.ParseYwWith(p, "1965-W10"); err != nil {
+		t.Errorf("%+v", err)
+	}
+
+	// 特殊値は年月日時分秒にも適用します
+	if _, err := types.ParseYmdhmsWith(nil, int64(99991231235959)); err != nil {
+		t.Errorf("%+v", err)
+	}
+
+	// 年月・月日の特殊値は年月日から導出しません（従来どおりエラー）
+	if _, err := types.ParseYm(999912); err == nil {
+		t.Errorf("999912: error expected")
+	}
+	if _, err := types.ParseYm(999999); err == nil {
+		t.Errorf("999999: error expected")
+	}
+	if _, err := types.ParseMd(9999); err == nil {
+		t.Errorf("9999: error expected")
+	}
+	if _, err := types.ParseMd(1231); err != nil {
+		t.Errorf("%+v", err)
+	}
+	q := &types.ValidationPolicy{MinYear: 1998, MaxYear: 2999, SentinelYms: []types.Ym{999912}, SentinelMds: []types.Md{9999}}
+	if _, err := types.ParseYmWith(q, 999912); err != nil {
+		t.Errorf("%+v", err)
+	}
+	if _, err := types.ParseMdWith(q, 9999); err != nil {
+		t.Errorf("%+v", err)
+	}
+	for _, x := range []struct {
+		v  string
+		ok bool
+	}{
+		{"18680101", true},
+		{"00010101", true},
+		{"99991231", true},
+		{"19000229", false},
+	} {
+		if _, err := types.ParseYmdWith(types.CalendarOnlyPolicy(), x.v); (err == nil) != x.ok {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.v, x.ok, err)
+		}
+	}
+
+	defer types.SetDefaultValidationPolicy(nil)
+	types.SetDefaultValidationPolicy(p)
+	if _, err := types.ParseYmd("19650315"); err != nil {
+		t.Errorf("%+v", err)
+	}
+	if _, err := types.ToYmd(1899, 12, 31); err == nil {
+		t.Errorf("error expected")
+	}
+}
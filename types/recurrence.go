@@ -0,0 +1,525 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MineTakaki/go-utils/errors"
+)
+
+type (
+	// Frequency 繰り返しの単位（RRULEのFREQ）
+	Frequency int
+
+	// WeekdayNum 曜日と序数（RRULEのBYDAYの要素）
+	//
+	//	Nが0の場合は期間内のすべての該当曜日、正の値は第N、負の値は最後からN番目を表します（-1: 最終）
+	WeekdayNum struct {
+		N       int
+		Weekday time.Weekday
+	}
+
+	// Recurrence 繰り返しの規則（RFC 5545 のRRULEのサブセット）
+	//
+	//	FREQ, INTERVAL, BYMONTHDAY, BYDAY, BYSETPOS, COUNT, UNTIL に対応します。
+	//	週はRFC 5545の既定と同じく月曜日始まりとして扱います
+	Recurrence struct {
+		Start      Ymdhms // 起点（DTSTART）。発生日時の時刻は起点の時刻になります
+		Freq       Frequency
+		Interval   int // 間隔（0の場合は1）
+		ByMonthDay []int
+		ByDay      []WeekdayNum
+		BySetPos   []int
+		Count      int    // 発生回数の上限（0の場合は無制限）
+		Until      Ymdhms // 終了日時（0の場合は無制限）
+
+		// Adjust 発生日を調整する関数（nilの場合は調整しません）
+		//
+		//	AdjustFollowing 等で営業日への移動を指定します。
+		//	COUNT, UNTILは調整前の日付に対して適用され、調整後に同じ日付になった発生は1回にまとめます。
+		//	調整後の日付が0の場合はその発生を除外します
+		Adjust func(Ymd) Ymd
+	}
+
+	// RecurrenceIterator 繰り返しの発生日時を順に取得するイテレータ
+	RecurrenceIterator struct {
+		r        Recurrence
+		from, to Ymdhms
+		period   int
+		buf      []Ymd
+		count    int
+		last     Ymdhms
+		done     bool
+	}
+)
+
+const (
+	// FreqDaily 日ごと
+	FreqDaily Frequency = iota + 1
+	// FreqWeekly 週ごと
+	FreqWeekly
+	// FreqMonthly 月ごと
+	FreqMonthly
+	// FreqYearly 年ごと
+	FreqYearly
+)
+
+// 発生日のない期間が続く場合に探索を打ち切る期間数
+const maxRecurrenceSearch = 1000
+
+var _frequencyNames = []string{"", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+var _rruleWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// String string型変換（RRULEの表記）
+func (f Frequency) String() string {
+	if f < FreqDaily || f > FreqYearly {
+		return ""
+	}
+	return _frequencyNames[f]
+}
+
+// String string型変換（RRULEの表記、2TU, -1FR 等）
+func (w WeekdayNum) String() string {
+	s := _rruleWeekdays[w.Weekday]
+	if w.N != 0 {
+		s = strconv.Itoa(w.N) + s
+	}
+	return s
+}
+
+// ParseRecurrence RRULEの文字列（"FREQ=MONTHLY;BYDAY=2TU" 等、"RRULE:"の接頭辞は省略可）を読み取ります
+func ParseRecurrence(start Ymdhms, rule string) (r Recurrence, err error) {
+	r.Start = start
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+		i := strings.IndexByte(part, '=')
+		if i < 0 {
+			return r, errors.Wrapf(ErrValidate, "incorrect rule part : '%s'", part)
+		}
+		key, val := strings.ToUpper(part[:i]), part[i+1:]
+		switch key {
+		case "FREQ":
+			r.Freq = 0
+			for f := FreqDaily; f <= FreqYearly; f++ {
+				if strings.EqualFold(val, f.String()) {
+					r.Freq = f
+				}
+			}
+			if r.Freq == 0 {
+				return r, errors.Wrapf(ErrValidate, "unsupported FREQ : '%s'", val)
+			}
+		case "INTERVAL":
+			r.Interval, err = parseRuleInt(key, val)
+		case "COUNT":
+			r.Count, err = parseRuleInt(key, val)
+		case "UNTIL":
+			r.Until, err = parseRuleUntil(val)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseRuleInts(key, val)
+		case "BYSETPOS":
+			r.BySetPos, err = parseRuleInts(key, val)
+		case "BYDAY":
+			r.ByDay, err = parseRuleByDay(val)
+		case "WKST":
+			if !strings.EqualFold(val, "MO") {
+				return r, errors.Wrapf(ErrValidate, "unsupported WKST : '%s'", val)
+			}
+		default:
+			return r, errors.Wrapf(ErrValidate, "unsupported rule part : '%s'", key)
+		}
+		if err != nil {
+			return
+		}
+	}
+	_, err = r.Validate()
+	return
+}
+
+func parseRuleInt(key, val string) (int, error) {
+	n, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, errors.Wrapf(ErrValidate, "incorrect %s : '%s'", key, val)
+	}
+	return n, nil
+}
+
+func parseRuleInts(key, val string) (v []int, err error) {
+	for _, s := range strings.Split(val, ",") {
+		var n int
+		if n, err = parseRuleInt(key, s); err != nil {
+			return
+		}
+		v = append(v, n)
+	}
+	return
+}
+
+func parseRuleUntil(val string) (Ymdhms, error) {
+	s := strings.TrimSuffix(strings.ToUpper(val), "Z")
+	if len(s) == 8 {
+		s += "T235959"
+	}
+	if len(s) != 15 || s[8] != 'T' || !isDigits(s[:8]) || !isDigits(s[9:]) {
+		return 0, errors.Wrapf(ErrValidate, "incorrect UNTIL : '%s'", val)
+	}
+	n, _ := strconv.ParseInt(s[:8]+s[9:], 10, 64)
+	return Ymdhms(n), nil
+}
+
+func parseRuleByDay(val string) (v []WeekdayNum, err error) {
+	for _, s := range strings.Split(strings.ToUpper(val), ",") {
+		if len(s) < 2 {
+			return nil, errors.Wrapf(ErrValidate, "incorrect BYDAY : '%s'", val)
+		}
+		w := WeekdayNum{Weekday: -1}
+		for i, name := range _rruleWeekdays {
+			if strings.HasSuffix(s, name) {
+				w.Weekday = time.Weekday(i)
+			}
+		}
+		if w.Weekday < 0 {
+			return nil, errors.Wrapf(ErrValidate, "incorrect BYDAY : '%s'", val)
+		}
+		if n := strings.TrimPrefix(s[:len(s)-2], "+"); n != "" {
+			if w.N, err = strconv.Atoi(n); err != nil || w.N == 0 {
+				return nil, errors.Wrapf(ErrValidate, "incorrect BYDAY : '%s'", val)
+			}
+		}
+		v = append(v, w)
+	}
+	return
+}
+
+// String string型変換（RRULEの表記、起点と調整関数は含みません）
+func (r Recurrence) String() string {
+	v := []string{"FREQ=" + r.Freq.String()}
+	if r.Interval > 1 {
+		v = append(v, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	ints := func(a []int) string {
+		s := make([]string, len(a))
+		for i, n := range a {
+			s[i] = strconv.Itoa(n)
+		}
+		return strings.Join(s, ",")
+	}
+	if len(r.ByMonthDay) > 0 {
+		v = append(v, "BYMONTHDAY="+ints(r.ByMonthDay))
+	}
+	if len(r.ByDay) > 0 {
+		s := make([]string, len(r.ByDay))
+		for i, w := range r.ByDay {
+			s[i] = w.String()
+		}
+		v = append(v, "BYDAY="+strings.Join(s, ","))
+	}
+	if len(r.BySetPos) > 0 {
+		v = append(v, "BYSETPOS="+ints(r.BySetPos))
+	}
+	if r.Count > 0 {
+		v = append(v, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != 0 {
+		s := r.Until.String()
+		v = append(v, "UNTIL="+s[:8]+"T"+s[8:])
+	}
+	return strings.Join(v, ";")
+}
+
+// Validate 規則が正しいか確認します（起点の年の範囲は問いません）
+func (r Recurrence) Validate() (bool, error) {
+	if r.Start == 0 {
+		return false, errors.Wrap(ErrValidate, "start is not set")
+	}
+	if _, err := r.Start.ValidateWith(CalendarOnlyPolicy()); err != nil {
+		return false, err
+	}
+	if r.Freq < FreqDaily || r.Freq > FreqYearly {
+		return false, errors.Wrapf(ErrValidate, "incorrect frequency : %d", r.Freq)
+	}
+	if r.Interval < 0 || r.Count < 0 {
+		return false, errors.Wrapf(ErrValidate, "incorrect interval or count : %d, %d", r.Interval, r.Count)
+	}
+	if r.Count > 0 && r.Until != 0 {
+		return false, errors.Wrap(ErrValidate, "count and until must not both be set")
+	}
+	for _, d := range r.ByMonthDay {
+		if d == 0 || d < -31 || d > 31 {
+			return false, errors.Wrapf(ErrValidate, "incorrect BYMONTHDAY : %d", d)
+		}
+	}
+	for _, w := range r.ByDay {
+		if w.Weekday < time.Sunday || w.Weekday > time.Saturday {
+			return false, errors.Wrapf(ErrValidate, "incorrect BYDAY weekday : %d", w.Weekday)
+		}
+		switch {
+		case w.N == 0:
+		case r.Freq == FreqMonthly && w.N >= -5 && w.N <= 5:
+		case r.Freq == FreqYearly && w.N >= -53 && w.N <= 53:
+		default:
+			return false, errors.Wrapf(ErrValidate, "incorrect BYDAY ordinal for %v : %v", r.Freq, w)
+		}
+	}
+	for _, n := range r.BySetPos {
+		if n == 0 || n < -366 || n > 366 {
+			return false, errors.Wrapf(ErrValidate, "incorrect BYSETPOS : %d", n)
+		}
+	}
+	return true, nil
+}
+
+// Iterator from～to（両端を含む）の発生日時を順に取得するイテレータを生成します
+//
+//	from, toが0の場合はその側の制限なしとして扱います。
+//	toとCOUNT, UNTILのいずれも指定しない場合、Nextは発生日時を無制限に返します
+func (r Recurrence) Iterator(from, to Ymdhms) (*RecurrenceIterator, error) {
+	if _, err := r.Validate(); err != nil {
+		return nil, err
+	}
+	if r.Interval == 0 {
+		r.Interval = 1
+	}
+	return &RecurrenceIterator{r: r, from: from, to: to}, nil
+}
+
+// Each 区間内の発生日時を順に処理します。fnがfalseを返した場合は中断します
+func (r Recurrence) Each(rg YmdhmsRange, fn func(Ymdhms) bool) error {
+	it, err := r.Iterator(rg.From, rg.To)
+	if err != nil {
+		return err
+	}
+	for yh, ok := it.Next(); ok && fn(yh); yh, ok = it.Next() {
+	}
+	return nil
+}
+
+// EachYmd 区間内の発生日を順に処理します。fnがfalseを返した場合は中断します
+func (r Recurrence) EachYmd(rg YmdRange, fn func(Ymd) bool) error {
+	var from, to Ymdhms
+	if rg.From != 0 {
+		from = rg.From.At(0)
+	}
+	if rg.To != 0 {
+		to = rg.To.At(235959)
+	}
+	it, err := r.Iterator(from, to)
+	if err != nil {
+		return err
+	}
+	for yh, ok := it.Next(); ok && fn(yh.Ymd()); yh, ok = it.Next() {
+	}
+	return nil
+}
+
+// Slice 区間内の発生日をスライスで取得します
+func (r Recurrence) Slice(rg YmdRange) (v YmdSlice, err error) {
+	err = r.EachYmd(rg, func(ymd Ymd) bool {
+		v = append(v, ymd)
+		return true
+	})
+	return
+}
+
+// Next 次の発生日時を取得します。発生日時がない場合はfalseを返します
+func (it *RecurrenceIterator) Next() (Ymdhms, bool) {
+	r := &it.r
+	hms := r.Start.Hms()
+	for !it.done {
+		if len(it.buf) == 0 {
+			it.fill()
+			continue
+		}
+		ymd := it.buf[0]
+		it.buf = it.buf[1:]
+		yh := ymd.At(hms)
+		if yh < r.Start {
+			continue
+		}
+		if r.Until != 0 && yh > r.Until {
+			it.done = true
+			break
+		}
+		if it.count++; r.Count > 0 && it.count > r.Count {
+			it.done = true
+			break
+		}
+		if r.Adjust != nil {
+			if ymd = r.Adjust(ymd); ymd == 0 {
+				continue
+			}
+			yh = ymd.At(hms)
+		}
+		if it.to != 0 && yh > it.to {
+			it.done = true
+			break
+		}
+		if yh == it.last || (it.from != 0 && yh < it.from) {
+			continue
+		}
+		it.last = yh
+		return yh, true
+	}
+	return 0, false
+}
+
+// fill 発生日のある期間まで進めて、その期間の発生日を読み込みます
+func (it *RecurrenceIterator) fill() {
+	for i := 0; i < maxRecurrenceSearch; i++ {
+		rg := it.r.period(it.period)
+		it.period++
+		if rg.From.Year() > 9999 || (it.r.Until != 0 && rg.From.At(0) > it.r.Until) {
+			break
+		}
+		if it.buf = it.r.expand(rg); len(it.buf) > 0 {
+			return
+		}
+	}
+	it.done = true
+}
+
+// period k番目の期間を取得します
+func (r Recurrence) period(k int) YmdRange {
+	start := r.Start.Ymd()
+	n := k * r.Interval
+	switch r.Freq {
+	case FreqDaily:
+		d := start.Add(0, 0, n)
+		return YmdRange{From: d, To: d}
+	case FreqWeekly:
+		monday := start.Add(0, 0, -isoWeekdayIndex(start)+n*7)
+		return YmdRange{From: monday, To: monday.Add(0, 0, 6)}
+	case FreqMonthly:
+		ym := start.YearMonth().Add(0, n)
+		return YmdRange{From: ym.Ymd(1), To: ym.Last()}
+	}
+	y := start.Year() + n
+	return YmdRange{From: Ymd(y*10000 + 101), To: Ymd(y*10000 + 1231)}
+}
+
+// expand 期間内の発生日を取得します
+func (r Recurrence) expand(rg YmdRange) (v []Ymd) {
+	start := r.Start.Ymd()
+	if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+		var d Ymd
+		switch r.Freq {
+		case FreqDaily:
+			d = rg.From
+		case FreqWeekly:
+			d = rg.From.Add(0, 0, isoWeekdayIndex(start))
+		case FreqMonthly:
+			d = Ymd(int(rg.From) + start.Day() - 1)
+		case FreqYearly:
+			d = Ymd(rg.From.Year()*10000 + int(start.MonthDay()))
+		}
+		// 月末を超える日（2/30等）は発生しません。年の範囲は妥当性確認の条件によらず扱います
+		if y, m, dd := d.Part(); dd <= LastDay(y, m) {
+			v = append(v, d)
+		}
+	} else {
+		rg.EachDay(func(d Ymd) bool {
+			if r.matchMonthDay(d) && r.matchDay(d) {
+				v = append(v, d)
+			}
+			return true
+		})
+	}
+	if len(r.BySetPos) == 0 || len(v) == 0 {
+		return
+	}
+	var s []Ymd
+	for _, n := range r.BySetPos {
+		if n < 0 {
+			n += len(v) + 1
+		}
+		if n >= 1 && n <= len(v) {
+			s = append(s, v[n-1])
+		}
+	}
+	Sort(s)
+	return Dedup(s)
+}
+
+func (r Recurrence) matchMonthDay(d Ymd) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	day, last := d.Day(), d.YearMonth().Last().Day()
+	for _, n := range r.ByMonthDay {
+		if n == day || n == day-last-1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Recurrence) matchDay(d Ymd) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	wd := d.Weekday()
+	// 序数の範囲（月ごとは月内、年ごとは年内）での位置
+	var idx, last int
+	if r.Freq == FreqYearly {
+		first := Ymd(d.Year()*10000 + 101)
+		idx = d.Days() - first.Days() + 1
+		last = Ymd(d.Year()*10000+1231).Days() - first.Days() + 1
+	} else {
+		idx, last = d.Day(), d.YearMonth().Last().Day()
+	}
+	for _, w := range r.ByDay {
+		if w.Weekday != wd {
+			continue
+		}
+		if w.N == 0 || w.N == (idx-1)/7+1 || w.N == -((last-idx)/7+1) {
+			return true
+		}
+	}
+	return false
+}
+
+// AdjustFollowing 休日の場合は翌営業日に移動する調整関数を取得します（cがnilの場合は既定のカレンダー）
+func AdjustFollowing(c *Calendar) func(Ymd) Ymd {
+	return func(ymd Ymd) Ymd {
+		return adjustBusinessDay(c, ymd, 1, false)
+	}
+}
+
+// AdjustPreceding 休日の場合は前営業日に移動する調整関数を取得します（cがnilの場合は既定のカレンダー）
+func AdjustPreceding(c *Calendar) func(Ymd) Ymd {
+	return func(ymd Ymd) Ymd {
+		return adjustBusinessDay(c, ymd, -1, false)
+	}
+}
+
+// AdjustModifiedFollowing 休日の場合は翌営業日に移動し、月が変わる場合は前営業日に移動する調整関数を取得します
+func AdjustModifiedFollowing(c *Calendar) func(Ymd) Ymd {
+	return func(ymd Ymd) Ymd {
+		return adjustBusinessDay(c, ymd, 1, true)
+	}
+}
+
+// AdjustModifiedPreceding 休日の場合は前営業日に移動し、月が変わる場合は翌営業日に移動する調整関数を取得します
+func AdjustModifiedPreceding(c *Calendar) func(Ymd) Ymd {
+	return func(ymd Ymd) Ymd {
+		return adjustBusinessDay(c, ymd, -1, true)
+	}
+}
+
+func adjustBusinessDay(c *Calendar, ymd Ymd, d int, modified bool) Ymd {
+	if c == nil {
+		c = DefaultCalendar()
+	}
+	if ymd == 0 || c.IsBusinessDay(ymd) {
+		return ymd
+	}
+	x := c.step(ymd, d)
+	if modified && (x == 0 || x.YearMonth() != ymd.YearMonth()) {
+		x = c.step(ymd, -d)
+	}
+	return x
+}
@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestRecurrence(t *testing.T) {
+	for _, x := range []struct {
+		start types.Ymdhms
+		rule  string
+		rg    types.YmdRange
+		exp   []types.Ymd
+	}{
+		{20240125000000, "FREQ=MONTHLY;BYMONTHDAY=25", types.YmdRange{From: 20240101, To: 20240531},
+			[]types.Ymd{20240125, 20240225, 20240325, 20240425, 20240525}},
+		{20240101000000, "FREQ=MONTHLY;BYDAY=2TU", types.YmdRange{From: 20240101, To: 20240430},
+			[]types.Ymd{20240109, 20240213, 20240312, 20240409}},
+		{20240101000000, "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3", types.YmdRange{},
+			[]types.Ymd{20240126, 20240223, 20240329}},
+		{20240101000000, "FREQ=MONTHLY;BYMONTHDAY=-1;INTERVAL=2;COUNT=3", types.YmdRange{},
+			[]types.Ymd{20240131, 20240331, 20240531}},
+		// 月の最終営業日（平日の最後）
+		{20240101000000, "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=3", types.YmdRange{},
+			[]types.Ymd{20240131, 20240229, 20240329}},
+		{20240101000000, "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;UNTIL=20240125", types.YmdRange{},
+			[]types.Ymd{20240102, 20240104, 20240116, 20240118}},
+		{20240103000000, "FREQ=DAILY;COUNT=5;BYDAY=MO,WE,FR", types.YmdRange{},
+			[]types.Ymd{20240103, 20240105, 20240108, 20240110, 20240112}},
+		{20240229000000, "FREQ=YEARLY;COUNT=2", types.YmdRange{},
+			[]types.Ymd{20240229, 20280229}},
+		{20240101000000, "RRULE:FREQ=YEARLY;BYDAY=20MO", types.YmdRange{To: 20251231},
+			[]types.Ymd{20240513, 20250519}},
+		// 範囲の前の発生もCOUNTに数えます（31日のない月は発生しません）
+		{20240131000000, "FREQ=MONTHLY;COUNT=3", types.YmdRange{From: 20240301},
+			[]types.Ymd{20240331, 20240531}},
+		// 既定の妥当性確認の年の範囲（1998年～）の外でも発生します
+		{19900131000000, "FREQ=MONTHLY;COUNT=3", types.YmdRange{},
+			[]types.Ymd{19900131, 19900331, 19900531}},
+		{19650315000000, "FREQ=YEARLY;COUNT=2", types.YmdRange{},
+			[]types.Ymd{19650315, 19660315}},
+	} {
+		r, err := types.ParseRecurrence(x.start, x.rule)
+		if err != nil {
+			t.Errorf("%s: %+v", x.rule, err)
+			continue
+		}
+		act, err := r.Slice(x.rg)
+		if err != nil || !reflect.DeepEqual([]types.Ymd(act), x.exp) {
+			t.Errorf("%s: expect(%v) != actual(%v) %v", x.rule, x.exp, act, err)
+		}
+		if s := r.String(); s == "" {
+			t.Errorf("%s: empty string", x.rule)
+		}
+	}
+}
+
+func TestRecurrenceAdjust(t *testing.T) {
+	cal := types.NewCalendar()
+	r, err := types.ParseRecurrence(20240125000000, "FREQ=MONTHLY;BYMONTHDAY=25")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	// 2024/2/25(日) → 2/22(木)（2/23は天皇誕生日）, 2024/5/25(土) → 5/24(金), 2024/8/25(日) → 8/23(金)
+	r.Adjust = types.AdjustPreceding(cal)
+	exp := []types.Ymd{20240125, 20240222, 20240325, 20240425, 20240524, 20240625, 20240725, 20240823}
+	if act, _ := r.Slice(types.YmdRange{To: 20240831}); !reflect.DeepEqual([]types.Ymd(act), exp) {
+		t.Errorf("expect(%v) != actual(%v)", exp, act)
+	}
+
+	// 2024/3/30(土) → 4/1(月)は月が変わるため 3/29(金)
+	r, _ = types.ParseRecurrence(20240130000000, "FREQ=MONTHLY;BYMONTHDAY=30;COUNT=3")
+	r.Adjust = types.AdjustModifiedFollowing(cal)
+	exp = []types.Ymd{20240130, 20240329, 20240430}
+	if act, _ := r.Slice(types.YmdRange{}); !reflect.DeepEqual([]types.Ymd(act), exp) {
+		t.Errorf("expect(%v) != actual(%v)", exp, act)
+	}
+}
+
+func TestRecurrenceIterator(t *testing.T) {
+	r := types.Recurrence{Start: 20240101093000, Freq: types.FreqWeekly, ByDay: []types.WeekdayNum{{Weekday: 1}}}
+	it, err := r.Iterator(20240110000000, 0)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, exp := range []types.Ymdhms{20240115093000, 20240122093000, 20240129093000} {
+		if act, ok := it.Next(); !ok || act != exp {
+			t.Errorf("expect(%v) != actual(%v)", exp, act)
+		}
+	}
+
+	for _, rule := range []string{
+		"FREQ=HOURLY",
+		"FREQ=WEEKLY;BYDAY=2TU",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=MONTHLY;COUNT=2;UNTIL=20241231",
+		"FREQ=MONTHLY;BYMONTH=3",
+		"FREQ=MONTHLY;BYDAY=XX",
+	} {
+		if _, err := types.ParseRecurrence(20240101000000, rule); err == nil {
+			t.Errorf("%s: error expected", rule)
+		}
+	}
+}
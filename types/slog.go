@@ -0,0 +1,87 @@
+//go:build go1.21
+
+package types
+
+import "log/slog"
+
+func nullLogValue(s string, valid bool) slog.Value {
+	if !valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(s)
+}
+
+// LogValue slog.LogValuerの実装
+func (ymd Ymd) LogValue() slog.Value {
+	return slog.StringValue(ymd.String())
+}
+
+// LogValue slog.LogValuerの実装
+func (ym Ym) LogValue() slog.Value {
+	return slog.StringValue(ym.String())
+}
+
+// LogValue slog.LogValuerの実装
+func (md Md) LogValue() slog.Value {
+	return slog.StringValue(md.String())
+}
+
+// LogValue slog.LogValuerの実装
+func (hms Hms) LogValue() slog.Value {
+	return slog.StringValue(hms.String())
+}
+
+// LogValue slog.LogValuerの実装
+func (yh Ymdhms) LogValue() slog.Value {
+	return slog.StringValue(yh.String())
+}
+
+// LogValue slog.LogValuerの実装
+func (x YmdhmsMs) LogValue() slog.Value {
+	return slog.StringValue(x.String())
+}
+
+// LogValue slog.LogValuerの実装
+func (yw Yw) LogValue() slog.Value {
+	return slog.StringValue(yw.String())
+}
+
+// LogValue slog.LogValuerの実装（NULLの場合はnil）
+func (n NullYmd) LogValue() slog.Value {
+	return nullLogValue(n.Ymd.String(), n.Valid)
+}
+
+// LogValue slog.LogValuerの実装（NULLの場合はnil）
+func (n NullYm) LogValue() slog.Value {
+	return nullLogValue(n.Ym.String(), n.Valid)
+}
+
+// LogValue slog.LogValuerの実装（NULLの場合はnil）
+func (n NullHms) LogValue() slog.Value {
+	return nullLogValue(n.Hms.String(), n.Valid)
+}
+
+// LogValue slog.LogValuerの実装（NULLの場合はnil）
+func (n NullYmdhms) LogValue() slog.Value {
+	return nullLogValue(n.Ymdhms.String(), n.Valid)
+}
+
+// LogValue slog.LogValuerの実装
+func (r YmdRange) LogValue() slog.Value {
+	return slog.StringValue(r.String())
+}
+
+// LogValue slog.LogValuerの実装
+func (r YmRange) LogValue() slog.Value {
+	return slog.StringValue(r.String())
+}
+
+// LogValue slog.LogValuerの実装
+func (r YmdhmsRange) LogValue() slog.Value {
+	return slog.StringValue(r.String())
+}
+
+// LogValue slog.LogValuerの実装
+func (r HmsRange) LogValue() slog.Value {
+	return slog.StringValue(r.String())
+}
@@ -0,0 +1,40 @@
+//go:build go1.21
+
+package types_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/MineTakaki/go-utils/types"
+	"github.com/MineTakaki/go-utils/types/decimal"
+)
+
+func TestLogValue(t *testing.T) {
+	buf := bytes.Buffer{}
+	log := slog.New(slog.NewJSONHandler(&buf, nil))
+	log.Info("test",
+		"ymd", types.Ymd(20240305),
+		"ymdhms", types.Ymdhms(20240305103015),
+		"hms", types.Hms(93000),
+		"null", types.NullYmd{},
+		"range", types.NewHmsRange(220000, 60000),
+		"amount", decimal.RequireFromString("12.50"),
+		"nullamount", decimal.Null,
+	)
+	for _, exp := range []string{
+		`"ymd":"20240305"`,
+		`"ymdhms":"20240305103015"`,
+		`"hms":"093000"`,
+		`"null":null`,
+		`"range":"220000-060000"`,
+		`"amount":"12.5"`,
+		`"nullamount":null`,
+	} {
+		if !strings.Contains(buf.String(), exp) {
+			t.Errorf("expect(%s) not in actual(%s)", exp, buf.String())
+		}
+	}
+}
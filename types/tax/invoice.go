@@ -0,0 +1,117 @@
+package tax
+
+import (
+	"sort"
+
+	"github.com/MineTakaki/go-utils/types/decimal"
+)
+
+// Method 税額の計算単位
+type Method int
+
+const (
+	// PerInvoice 請求書ごとに税率別の合計金額から税額を計算します（適格請求書の端数処理は税率ごとに1回）
+	PerInvoice Method = iota
+	// PerLine 明細ごとに税額を計算して端数処理し、税率別に合計します
+	PerLine
+)
+
+// String string型変換
+func (m Method) String() string {
+	switch m {
+	case PerInvoice:
+		return "請求書単位"
+	case PerLine:
+		return "明細単位"
+	}
+	return ""
+}
+
+// Line 明細
+type Line struct {
+	Amount decimal.Decimal // 金額（Invoice.Inclusive が true の場合は税込金額）
+	Rate   Rate
+}
+
+// Invoice 請求書の税額計算
+type Invoice struct {
+	Lines     []Line
+	Inclusive bool     // 明細の金額が税込金額か
+	Rounding  Rounding // 端数処理の方法
+	Method    Method   // 税額の計算単位
+}
+
+// Summary 税率別の合計
+type Summary struct {
+	Rate      Rate
+	Exclusive decimal.Decimal // 税抜金額
+	Tax       decimal.Decimal // 消費税額
+	Inclusive decimal.Decimal // 税込金額
+}
+
+// Add 明細を追加します
+func (inv *Invoice) Add(amount decimal.Decimal, r Rate) {
+	inv.Lines = append(inv.Lines, Line{Amount: amount, Rate: r})
+}
+
+// calc 金額から税抜金額・税額・税込金額を計算します
+func (inv Invoice) calc(amount decimal.Decimal, r Rate) Summary {
+	s := Summary{Rate: r}
+	if inv.Inclusive {
+		s.Inclusive = amount
+		s.Tax, s.Exclusive = r.ExtractTax(amount, inv.Rounding)
+	} else {
+		s.Exclusive = amount
+		s.Tax, s.Inclusive = r.Inclusive(amount, inv.Rounding)
+	}
+	return s
+}
+
+// Summaries 税率別の合計を取得します（標準税率・軽減税率の順、同じ区分は税率の高い順）
+func (inv Invoice) Summaries() []Summary {
+	var v []Summary
+	for _, l := range inv.Lines {
+		i := 0
+		for ; i < len(v) && !v[i].Rate.Equal(l.Rate); i++ {
+		}
+		if i == len(v) {
+			v = append(v, Summary{Rate: l.Rate})
+		}
+		if inv.Method == PerLine {
+			s := inv.calc(l.Amount, l.Rate)
+			v[i].Exclusive = v[i].Exclusive.Add(s.Exclusive)
+			v[i].Tax = v[i].Tax.Add(s.Tax)
+			v[i].Inclusive = v[i].Inclusive.Add(s.Inclusive)
+		} else if inv.Inclusive {
+			v[i].Inclusive = v[i].Inclusive.Add(l.Amount)
+		} else {
+			v[i].Exclusive = v[i].Exclusive.Add(l.Amount)
+		}
+	}
+	if inv.Method != PerLine {
+		for i, s := range v {
+			if inv.Inclusive {
+				v[i] = inv.calc(s.Inclusive, s.Rate)
+			} else {
+				v[i] = inv.calc(s.Exclusive, s.Rate)
+			}
+		}
+	}
+	sort.SliceStable(v, func(i, j int) bool {
+		if v[i].Rate.Category != v[j].Rate.Category {
+			return v[i].Rate.Category < v[j].Rate.Category
+		}
+		return v[i].Rate.Percent.GreaterThan(v[j].Rate.Percent)
+	})
+	return v
+}
+
+// Total 請求書の合計を取得します（Rateは0値）
+func (inv Invoice) Total() (total Summary) {
+	for _, s := range inv.Summaries() {
+		total.Exclusive = total.Exclusive.Add(s.Exclusive)
+		total.Tax = total.Tax.Add(s.Tax)
+		total.Inclusive = total.Inclusive.Add(s.Inclusive)
+	}
+	return
+}
@@ -0,0 +1,111 @@
+package tax
+
+import (
+	goerr "errors"
+	"sort"
+	"sync"
+
+	"github.com/MineTakaki/go-utils/errors"
+	"github.com/MineTakaki/go-utils/types"
+	"github.com/MineTakaki/go-utils/types/decimal"
+)
+
+// ErrNoRate 日付に適用する税率がない
+var ErrNoRate = goerr.New("no tax rate")
+
+// RatePeriod 適用開始日ごとの税率
+type RatePeriod struct {
+	From     types.Ymd       // 適用開始日
+	Standard decimal.Decimal // 標準税率（%）
+	Reduced  decimal.Decimal // 軽減税率（%）。軽減税率がない期間は0
+}
+
+// RateTable 適用開始日ごとの税率表
+type RateTable struct {
+	periods []RatePeriod
+}
+
+// NewRateTable 税率表を生成します
+func NewRateTable(periods ...RatePeriod) (*RateTable, error) {
+	v := append([]RatePeriod{}, periods...)
+	for _, p := range v {
+		if _, err := p.From.ValidateWith(types.CalendarOnlyPolicy()); err != nil {
+			return nil, err
+		}
+		if p.Standard.IsNegative() || p.Reduced.IsNegative() {
+			return nil, errors.Wrapf(types.ErrValidate, "negative tax rate : %v", p.From)
+		}
+	}
+	sort.SliceStable(v, func(i, j int) bool { return v[i].From < v[j].From })
+	for i := 1; i < len(v); i++ {
+		if v[i-1].From == v[i].From {
+			return nil, errors.Wrapf(types.ErrValidate, "duplicate tax rate period : %v", v[i].From)
+		}
+	}
+	return &RateTable{periods: v}, nil
+}
+
+// MustRateTable 税率表を生成します（エラーの場合はpanicします）
+func MustRateTable(periods ...RatePeriod) *RateTable {
+	t, err := NewRateTable(periods...)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// JapanRateTable 日本の消費税の税率表（3%, 5%, 8%, 10%・軽減税率8%）
+var JapanRateTable = MustRateTable(
+	RatePeriod{From: 19890401, Standard: decimal.NewFromInt(3)},
+	RatePeriod{From: 19970401, Standard: decimal.NewFromInt(5)},
+	RatePeriod{From: 20140401, Standard: decimal.NewFromInt(8)},
+	RatePeriod{From: 20191001, Standard: decimal.NewFromInt(10), Reduced: decimal.NewFromInt(8)},
+)
+
+var _defaultRateTableMu sync.RWMutex
+var _defaultRateTable = JapanRateTable
+
+// DefaultRateTable RateOf で使用する税率表を取得します
+func DefaultRateTable() *RateTable {
+	_defaultRateTableMu.RLock()
+	defer _defaultRateTableMu.RUnlock()
+	return _defaultRateTable
+}
+
+// SetDefaultRateTable RateOf で使用する税率表を設定します（nilの場合は JapanRateTable）
+func SetDefaultRateTable(t *RateTable) {
+	if t == nil {
+		t = JapanRateTable
+	}
+	_defaultRateTableMu.Lock()
+	_defaultRateTable = t
+	_defaultRateTableMu.Unlock()
+}
+
+// RateOf 既定の税率表から日付に適用する税率を取得します
+func RateOf(ymd types.Ymd, c Category) (Rate, error) {
+	return DefaultRateTable().Rate(ymd, c)
+}
+
+// Period 日付に適用する税率の期間を取得します
+func (t *RateTable) Period(ymd types.Ymd) (RatePeriod, error) {
+	i := sort.Search(len(t.periods), func(i int) bool { return t.periods[i].From > ymd })
+	if ymd == 0 || i == 0 {
+		return RatePeriod{}, errors.Wrapf(ErrNoRate, "%v", ymd)
+	}
+	return t.periods[i-1], nil
+}
+
+// Rate 日付に適用する税率を取得します
+//
+//	軽減税率がない期間に軽減税率を指定した場合は標準税率を返します
+func (t *RateTable) Rate(ymd types.Ymd, c Category) (Rate, error) {
+	p, err := t.Period(ymd)
+	if err != nil {
+		return Rate{}, err
+	}
+	if c == Reduced && !p.Reduced.IsZero() {
+		return Rate{Category: Reduced, Percent: p.Reduced}, nil
+	}
+	return Rate{Category: Standard, Percent: p.Standard}, nil
+}
@@ -0,0 +1,110 @@
+// Package tax 消費税の計算
+//
+//	税額は円単位で端数処理します。端数処理の方法（切り捨て・四捨五入・切り上げ）は取引先ごとに指定できます
+package tax
+
+import (
+	"github.com/MineTakaki/go-utils/types/decimal"
+)
+
+// Rounding 税額の端数処理の方法
+//
+//	負の金額（返品・値引き等）の場合も絶対値に対して同じ処理を行います（切り捨ては0方向）
+type Rounding int
+
+const (
+	// RoundDown 切り捨て
+	RoundDown Rounding = iota
+	// RoundHalfUp 四捨五入
+	RoundHalfUp
+	// RoundUp 切り上げ
+	RoundUp
+)
+
+// String string型変換
+func (r Rounding) String() string {
+	switch r {
+	case RoundDown:
+		return "切り捨て"
+	case RoundHalfUp:
+		return "四捨五入"
+	case RoundUp:
+		return "切り上げ"
+	}
+	return ""
+}
+
+// Mode decimal.RoundingModeに変換します
+func (r Rounding) Mode() decimal.RoundingMode {
+	switch r {
+	case RoundHalfUp:
+		return decimal.RoundHalfUp
+	case RoundUp:
+		return decimal.RoundUp
+	}
+	return decimal.RoundDown
+}
+
+// Apply 円未満の端数を処理します
+func (r Rounding) Apply(d decimal.Decimal) decimal.Decimal {
+	return d.RoundWith(0, r.Mode())
+}
+
+// Category 税率の区分
+type Category int
+
+const (
+	// Standard 標準税率
+	Standard Category = iota
+	// Reduced 軽減税率
+	Reduced
+)
+
+// String string型変換
+func (c Category) String() string {
+	switch c {
+	case Standard:
+		return "標準税率"
+	case Reduced:
+		return "軽減税率"
+	}
+	return ""
+}
+
+// Rate 税率
+type Rate struct {
+	Category Category
+	Percent  decimal.Decimal // 税率（%）
+}
+
+// String string型変換（"10%", "8%（軽減）"）
+func (r Rate) String() string {
+	s := r.Percent.String() + "%"
+	if r.Category == Reduced {
+		s += "（軽減）"
+	}
+	return s
+}
+
+// Equal 税率の区分と税率が等しいか判定します
+func (r Rate) Equal(o Rate) bool {
+	return r.Category == o.Category && r.Percent.Equal(o.Percent)
+}
+
+// Tax 税抜金額から税額を計算します
+func (r Rate) Tax(exclusive decimal.Decimal, rd Rounding) decimal.Decimal {
+	return rd.Apply(exclusive.Mul(r.Percent).Shift(-2))
+}
+
+// Inclusive 税抜金額から税額と税込金額を計算します
+func (r Rate) Inclusive(exclusive decimal.Decimal, rd Rounding) (tax, inclusive decimal.Decimal) {
+	tax = r.Tax(exclusive, rd)
+	return tax, exclusive.Add(tax)
+}
+
+// ExtractTax 税込金額に含まれる税額と税抜金額を計算します（税額 = 税込金額 × 税率 ÷ (100 + 税率)）
+func (r Rate) ExtractTax(inclusive decimal.Decimal, rd Rounding) (tax, exclusive decimal.Decimal) {
+	c := decimal.NewContext(0, rd.Mode())
+	tax = c.Div(inclusive.Mul(r.Percent), r.Percent.Add(decimal.NewFromInt(100)))
+	return tax, inclusive.Sub(tax)
+}
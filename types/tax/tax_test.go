@@ -0,0 +1,175 @@
+package tax_test
+
+import (
+	"testing"
+
+	"github.com/MineTakaki/go-utils/errors"
+	"github.com/MineTakaki/go-utils/types"
+	"github.com/MineTakaki/go-utils/types/decimal"
+	"github.com/MineTakaki/go-utils/types/tax"
+)
+
+var d = decimal.RequireFromString
+
+var (
+	rate10 = tax.Rate{Category: tax.Standard, Percent: decimal.NewFromInt(10)}
+	rate8r = tax.Rate{Category: tax.Reduced, Percent: decimal.NewFromInt(8)}
+)
+
+func TestRounding(t *testing.T) {
+	for _, tc := range []struct {
+		rd     tax.Rounding
+		src    string
+		expect string
+	}{
+		{tax.RoundDown, "10.9", "10"},
+		{tax.RoundDown, "-10.9", "-10"},
+		{tax.RoundHalfUp, "10.5", "11"},
+		{tax.RoundHalfUp, "10.49", "10"},
+		{tax.RoundHalfUp, "-10.5", "-11"},
+		{tax.RoundUp, "10.1", "11"},
+		{tax.RoundUp, "10", "10"},
+		{tax.RoundUp, "-10.1", "-11"},
+	} {
+		if act := tc.rd.Apply(d(tc.src)).String(); act != tc.expect {
+			t.Errorf("%v %s: expect(%v) != actual(%v)", tc.rd, tc.src, tc.expect, act)
+		}
+	}
+}
+
+func TestRateTax(t *testing.T) {
+	for _, tc := range []struct {
+		rate     tax.Rate
+		amount   string
+		rd       tax.Rounding
+		tax      string
+		extTax   string
+		extExclu string
+	}{
+		{rate10, "1234", tax.RoundDown, "123", "112", "1122"},
+		{rate10, "1235", tax.RoundHalfUp, "124", "112", "1123"},
+		{rate10, "1231", tax.RoundUp, "124", "112", "1119"},
+		{rate8r, "1000", tax.RoundDown, "80", "74", "926"},
+		{rate8r, "1000", tax.RoundUp, "80", "75", "925"},
+		{rate10, "1100", tax.RoundUp, "110", "100", "1000"},
+		{rate10, "-1234", tax.RoundDown, "-123", "-112", "-1122"},
+	} {
+		if act := tc.rate.Tax(d(tc.amount), tc.rd).String(); act != tc.tax {
+			t.Errorf("Tax %v %s %v: expect(%v) != actual(%v)", tc.rate, tc.amount, tc.rd, tc.tax, act)
+		}
+		tx, ex := tc.rate.ExtractTax(d(tc.amount), tc.rd)
+		if tx.String() != tc.extTax || ex.String() != tc.extExclu {
+			t.Errorf("ExtractTax %v %s %v: expect(%v, %v) != actual(%v, %v)", tc.rate, tc.amount, tc.rd, tc.extTax, tc.extExclu, tx, ex)
+		}
+	}
+}
+
+func TestRateTable(t *testing.T) {
+	for _, tc := range []struct {
+		ymd    types.Ymd
+		c      tax.Category
+		expect string
+		err    bool
+	}{
+		{19890331, tax.Standard, "", true},
+		{0, tax.Standard, "", true},
+		{19890401, tax.Standard, "3%", false},
+		{19970331, tax.Standard, "3%", false},
+		{19970401, tax.Standard, "5%", false},
+		{20140401, tax.Standard, "8%", false},
+		{20140401, tax.Reduced, "8%", false},
+		{20190930, tax.Reduced, "8%", false},
+		{20191001, tax.Standard, "10%", false},
+		{20191001, tax.Reduced, "8%（軽減）", false},
+	} {
+		r, err := tax.RateOf(tc.ymd, tc.c)
+		if tc.err {
+			if !errors.Is(err, tax.ErrNoRate) {
+				t.Errorf("%v: expect ErrNoRate, actual %v", tc.ymd, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: %+v", tc.ymd, err)
+		} else if r.String() != tc.expect {
+			t.Errorf("%v %v: expect(%v) != actual(%v)", tc.ymd, tc.c, tc.expect, r)
+		}
+	}
+
+	if _, err := tax.NewRateTable(
+		tax.RatePeriod{From: 20200101, Standard: decimal.NewFromInt(10)},
+		tax.RatePeriod{From: 20200101, Standard: decimal.NewFromInt(8)},
+	); !errors.Is(err, types.ErrValidate) {
+		t.Errorf("duplicate period: expect ErrValidate, actual %v", err)
+	}
+	if _, err := tax.NewRateTable(tax.RatePeriod{From: 20200230, Standard: decimal.NewFromInt(10)}); err == nil {
+		t.Errorf("invalid date: expect error")
+	}
+}
+
+func TestInvoice(t *testing.T) {
+	lines := []tax.Line{
+		{Amount: d("105"), Rate: rate10},
+		{Amount: d("105"), Rate: rate10},
+		{Amount: d("105"), Rate: rate10},
+		{Amount: d("999"), Rate: rate8r},
+		{Amount: d("1"), Rate: rate8r},
+	}
+	type summary struct{ ex, tx, in string }
+	for _, tc := range []struct {
+		name      string
+		inclusive bool
+		method    tax.Method
+		rd        tax.Rounding
+		expect    []summary
+		total     summary
+	}{
+		// 請求書単位: 315 × 10% = 31.5 → 31, 1000 × 8% = 80
+		{"exclusive per invoice", false, tax.PerInvoice, tax.RoundDown,
+			[]summary{{"315", "31", "346"}, {"1000", "80", "1080"}}, summary{"1315", "111", "1426"}},
+		// 明細単位: 10.5 → 10 × 3, 79.92 → 79, 0.08 → 0
+		{"exclusive per line", false, tax.PerLine, tax.RoundDown,
+			[]summary{{"315", "30", "345"}, {"1000", "79", "1079"}}, summary{"1315", "109", "1424"}},
+		{"exclusive per line half up", false, tax.PerLine, tax.RoundHalfUp,
+			[]summary{{"315", "33", "348"}, {"1000", "80", "1080"}}, summary{"1315", "113", "1428"}},
+		// 税込: 315 × 10/110 = 28.63 → 28, 1000 × 8/108 = 74.07 → 74
+		{"inclusive per invoice", true, tax.PerInvoice, tax.RoundDown,
+			[]summary{{"287", "28", "315"}, {"926", "74", "1000"}}, summary{"1213", "102", "1315"}},
+		{"inclusive per invoice up", true, tax.PerInvoice, tax.RoundUp,
+			[]summary{{"286", "29", "315"}, {"925", "75", "1000"}}, summary{"1211", "104", "1315"}},
+	} {
+		inv := tax.Invoice{Lines: lines, Inclusive: tc.inclusive, Method: tc.method, Rounding: tc.rd}
+		v := inv.Summaries()
+		if len(v) != len(tc.expect) {
+			t.Errorf("%s: expect(%v) != actual(%v)", tc.name, tc.expect, v)
+			continue
+		}
+		for i, s := range v {
+			act := summary{s.Exclusive.String(), s.Tax.String(), s.Inclusive.String()}
+			if act != tc.expect[i] {
+				t.Errorf("%s %v: expect(%v) != actual(%v)", tc.name, s.Rate, tc.expect[i], act)
+			}
+		}
+		s := inv.Total()
+		if act := (summary{s.Exclusive.String(), s.Tax.String(), s.Inclusive.String()}); act != tc.total {
+			t.Errorf("%s total: expect(%v) != actual(%v)", tc.name, tc.total, act)
+		}
+	}
+}
+
+func TestInvoiceOrder(t *testing.T) {
+	var inv tax.Invoice
+	inv.Add(d("100"), rate8r)
+	inv.Add(d("100"), tax.Rate{Category: tax.Standard, Percent: decimal.NewFromInt(8)})
+	inv.Add(d("100"), rate10)
+	expect := []string{"10%", "8%", "8%（軽減）"}
+	v := inv.Summaries()
+	if len(v) != len(expect) {
+		t.Fatalf("expect(%v) != actual(%v)", expect, v)
+	}
+	for i, s := range v {
+		if s.Rate.String() != expect[i] {
+			t.Errorf("[%d]: expect(%v) != actual(%v)", i, expect[i], s.Rate)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package types
+
+import "sort"
+
+type (
+	// Temporal 整数で表す日付・時刻型（Ymd, Ym, Md, Hms, Ymdhms, YmdhmsMs, Yw）の共通の制約
+	//
+	//	いずれの型も0を未設定の値として扱います
+	Temporal[T any] interface {
+		~int | ~int64
+		Compare(T) int
+		Validate() (bool, error)
+		Parts() []int
+		String() string
+	}
+
+	// Slice 日付・時刻型のスライス（sort.Interfaceを実装します）
+	Slice[T Temporal[T]] []T
+)
+
+func (s Slice[T]) Len() int           { return len(s) }
+func (s Slice[T]) Less(i, j int) bool { return s[i] < s[j] }
+func (s Slice[T]) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// MinOf 最小の値を取得します。0は未設定として除外し、すべて0の場合は0を返します
+func MinOf[T Temporal[T]](v ...T) (x T) {
+	for _, o := range v {
+		if o != 0 && (x == 0 || o < x) {
+			x = o
+		}
+	}
+	return
+}
+
+// MaxOf 最大の値を取得します。0は未設定として除外し、すべて0の場合は0を返します
+func MaxOf[T Temporal[T]](v ...T) (x T) {
+	for _, o := range v {
+		if o != 0 && o > x {
+			x = o
+		}
+	}
+	return
+}
+
+// Sort 昇順に並べ替えます
+func Sort[T Temporal[T]](v []T) {
+	sort.Sort(Slice[T](v))
+}
+
+// Clamp 値をlo～hiの範囲に収めます。vが0の場合は0を、lo, hiが0の場合はその側の制限なしとして扱います
+func Clamp[T Temporal[T]](v, lo, hi T) T {
+	if v == 0 {
+		return 0
+	}
+	if lo != 0 && v < lo {
+		return lo
+	}
+	if hi != 0 && v > hi {
+		return hi
+	}
+	return v
+}
+
+// BinarySearch 昇順に並んだスライスから値を探します
+//
+//	見つからない場合は挿入位置とfalseを返します
+func BinarySearch[T Temporal[T]](v []T, x T) (int, bool) {
+	i := sort.Search(len(v), func(i int) bool { return v[i] >= x })
+	return i, i < len(v) && v[i] == x
+}
+
+// Dedup 昇順に並んだスライスから重複した値を取り除きます（引数のスライスを再利用します）
+func Dedup[T Temporal[T]](v []T) []T {
+	if len(v) < 2 {
+		return v
+	}
+	r := v[:1]
+	for _, x := range v[1:] {
+		if x != r[len(r)-1] {
+			r = append(r, x)
+		}
+	}
+	return r
+}
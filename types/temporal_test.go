@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestTemporalHelpers(t *testing.T) {
+	if act := types.MinOf[Ymd](20240301, 0, 20240105, 20241231); act != 20240105 {
+		t.Errorf("expect(%v) != actual(%v)", 20240105, act)
+	}
+	if act := types.MaxOf[types.Hms](100000, 0, 235959); act != 235959 {
+		t.Errorf("expect(%v) != actual(%v)", 235959, act)
+	}
+	if act := types.MinOf[Ym](); act != 0 {
+		t.Errorf("expect(%v) != actual(%v)", 0, act)
+	}
+
+	v := []types.Ymdhms{20240102000000, 20240101000000, 20240102000000, 20240101120000}
+	types.Sort(v)
+	v = types.Dedup(v)
+	if exp := []types.Ymdhms{20240101000000, 20240101120000, 20240102000000}; !reflect.DeepEqual(v, exp) {
+		t.Errorf("expect(%v) != actual(%v)", exp, v)
+	}
+	if i, ok := types.BinarySearch(v, 20240101120000); !ok || i != 1 {
+		t.Errorf("expect(1, true) != actual(%d, %v)", i, ok)
+	}
+	if i, ok := types.BinarySearch(v, 20240101130000); ok || i != 2 {
+		t.Errorf("expect(2, false) != actual(%d, %v)", i, ok)
+	}
+
+	for _, x := range []struct {
+		v, lo, hi, exp Md
+	}{
+		{101, 401, 1231, 401},
+		{1231, 401, 930, 930},
+		{501, 401, 0, 501},
+		{0, 401, 930, 0},
+	} {
+		if act := types.Clamp(x.v, x.lo, x.hi); act != x.exp {
+			t.Errorf("expect(%v) != actual(%v)", x.exp, act)
+		}
+	}
+
+	// 従来のスライス型はSliceの別名
+	s := types.YmSlice{202403, 202401, 202402}
+	sort.Sort(s)
+	if exp := (types.Slice[Ym]{202401, 202402, 202403}); !reflect.DeepEqual(s, exp) {
+		t.Errorf("expect(%v) != actual(%v)", exp, s)
+	}
+}
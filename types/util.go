@@ -89,12 +89,9 @@ func ValidateMd(m, d int) (bool, error) {
 	return false, errors.Wrapf(ErrValidate, "incorrect month/day value. m:%d, d:%d", m, d)
 }
 
-// ValidateYear 年が有効か確認します
+// ValidateYear 年が有効か確認します（既定の妥当性確認の条件を使用します）
 func ValidateYear(y int) (bool, error) {
-	if y >= 1998 && y <= 2999 {
-		return true, nil
-	}
-	return false, errors.Wrapf(ErrValidate, "%d is not correct as a year value", y)
+	return DefaultValidationPolicy().ValidateYear(y)
 }
 
 // ValidateMonth 月が有効か確認します
@@ -105,30 +102,14 @@ func ValidateMonth(m int) (ok bool, err error) {
 	return true, nil
 }
 
-// ValidateYm 年月が有効か確認します
+// ValidateYm 年月が有効か確認します（既定の妥当性確認の条件を使用します）
 func ValidateYm(y, m int) (ok bool, err error) {
-	ok, err = ValidateYear(y)
-	if err != nil {
-		return
-	}
-	ok, err = ValidateMonth(m)
-	return
+	return DefaultValidationPolicy().ValidateYm(y, m)
 }
 
-// ValidateYmd 年月日が有効か確認します
+// ValidateYmd 年月日が有効か確認します（既定の妥当性確認の条件を使用します）
 func ValidateYmd(y, m, d int) (bool, error) {
-	if y == 9999 { //ターミネータ的な使用目的だけ例外的にOKとする
-		if m == 12 && d == 31 {
-			return true, nil
-		} else if m == 99 && d == 99 {
-			return true, nil
-		}
-	} else if y >= 1998 && y <= 2999 && m >= 1 && m <= 12 && d >= 1 {
-		if lday := LastDay(y, m); d <= lday {
-			return true, nil
-		}
-	}
-	return false, errors.Wrapf(ErrValidate, "incorrect date value. y:%d, m:%d, d:%d", y, m, d)
+	return DefaultValidationPolicy().ValidateYmd(y, m, d)
 }
 
 // ValidateHms 時分秒が有効か確認します
@@ -139,16 +120,17 @@ func ValidateHms(h, m, s int) (bool, error) {
 	return false, errors.Wrapf(ErrValidate, "incorrect time value. h:%d, m:%d, s:%d", h, m, s)
 }
 
-// ValidateYmdhms 年月日時分秒が有効か確認します
-func ValidateYmdhms(y, m, d, h, n, s int) (bool, error) {
-	if y >= 1998 && y <= 2999 && m >= 1 && m <= 12 && d >= 1 && d <= 31 {
-		if h >= 0 && h <= 23 && n >= 0 && n <= 59 && s >= 0 && s <= 59 {
-			if lday := LastDay(y, m); d <= lday {
-				return true, nil
-			}
-		}
+// ValidateHmsExtended 24時以降の表記（24:00:00～47:59:59）を含めて時分秒が有効か確認します
+func ValidateHmsExtended(h, m, s int) (bool, error) {
+	if h >= 0 && h <= 47 && m >= 0 && m <= 59 && s >= 0 && s <= 59 {
+		return true, nil
 	}
-	return false, errors.Wrapf(ErrValidate, "incorrect date value. y:%d, m:%d, d:%d, h:%d, n:%d, s:%d", y, m, d, h, n, s)
+	return false, errors.Wrapf(ErrValidate, "incorrect extended time value. h:%d, m:%d, s:%d", h, m, s)
+}
+
+// ValidateYmdhms 年月日時分秒が有効か確認します（既定の妥当性確認の条件を使用します）
+func ValidateYmdhms(y, m, d, h, n, s int) (bool, error) {
+	return DefaultValidationPolicy().ValidateYmdhms(y, m, d, h, n, s)
 }
 
 func abs(n int) int {
@@ -0,0 +1,369 @@
+package types
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/MineTakaki/go-utils/errors"
+)
+
+type (
+	// Era 元号の定義
+	Era struct {
+		Name  string `json:"name"`  // 元号（令和）
+		Abbr  string `json:"abbr"`  // アルファベット略称（R）
+		Start Ymd    `json:"start"` // 開始日（元年の初日）
+	}
+
+	// WarekiFlag 和暦書式のオプション
+	WarekiFlag int
+)
+
+const (
+	// WarekiAbbr 元号をアルファベット略称で出力します（R6.03.05）
+	WarekiAbbr WarekiFlag = 1 << iota
+	// WarekiGannen 1年を「元年」と出力します（略称の場合は無視されます）
+	WarekiGannen
+	// WarekiZeroPad 年・月・日を2桁で0埋めします
+	WarekiZeroPad
+)
+
+var _eraMu sync.RWMutex
+
+// 開始日の昇順で保持します
+var _eras = []Era{
+	{Name: "明治", Abbr: "M", Start: 18680125},
+	{Name: "大正", Abbr: "T", Start: 19120730},
+	{Name: "昭和", Abbr: "S", Start: 19261225},
+	{Name: "平成", Abbr: "H", Start: 19890108},
+	{Name: "令和", Abbr: "R", Start: 20190501},
+}
+
+// Eras 登録されている元号の一覧を開始日の昇順で取得します
+func Eras() []Era {
+	_eraMu.RLock()
+	defer _eraMu.RUnlock()
+	v := make([]Era, len(_eras))
+	copy(v, _eras)
+	return v
+}
+
+func validateEra(e Era) error {
+	if e.Name == "" {
+		return errors.Wrap(ErrValidate, "era name is empty")
+	}
+	if e.Start == 0 {
+		return errors.Wrapf(ErrValidate, "era start is empty : %s", e.Name)
+	}
+	y, m, d := e.Start.Part()
+	if !(m >= 1 && m <= 12 && d >= 1 && d <= LastDay(y, m)) {
+		return errors.Wrapf(ErrValidate, "incorrect era start : %s, %v", e.Name, e.Start)
+	}
+	return nil
+}
+
+// SetEras 元号の一覧を置き換えます
+//
+//	設定ファイル等から読み込んだ定義で新元号をリリースなしに追加する用途を想定しています
+func SetEras(eras []Era) error {
+	v := make([]Era, len(eras))
+	copy(v, eras)
+	for _, e := range v {
+		if err := validateEra(e); err != nil {
+			return err
+		}
+	}
+	sort.SliceStable(v, func(i, j int) bool { return v[i].Start < v[j].Start })
+	for i := 1; i < len(v); i++ {
+		if v[i-1].Start == v[i].Start {
+			return errors.Wrapf(ErrValidate, "duplicate era start : %s, %s", v[i-1].Name, v[i].Name)
+		}
+	}
+	_eraMu.Lock()
+	_eras = v
+	_eraMu.Unlock()
+	return nil
+}
+
+// AddEra 元号を追加します
+func AddEra(e Era) error {
+	return SetEras(append(Eras(), e))
+}
+
+// eraIndex 日付が属する元号のインデックスを取得します
+func eraIndex(eras []Era, ymd Ymd) int {
+	return sort.Search(len(eras), func(i int) bool { return eras[i].Start > ymd }) - 1
+}
+
+// EraOf 日付が属する元号と和暦年を取得します。該当する元号がない場合はokがfalseになります
+func EraOf(ymd Ymd) (e Era, year int, ok bool) {
+	if ymd == 0 {
+		return
+	}
+	_eraMu.RLock()
+	defer _eraMu.RUnlock()
+	i := eraIndex(_eras, ymd)
+	if i < 0 {
+		return
+	}
+	e = _eras[i]
+	year = ymd.Year() - e.Start.Year() + 1
+	ok = true
+	return
+}
+
+func (f WarekiFlag) has(o WarekiFlag) bool {
+	return f&o != 0
+}
+
+func warekiNum(n int, flag WarekiFlag) string {
+	if flag.has(WarekiZeroPad) {
+		return fillZero2(n)
+	}
+	return strconv.Itoa(n)
+}
+
+func formatWarekiYear(sb *strings.Builder, e Era, year int, flag WarekiFlag) {
+	if flag.has(WarekiAbbr) {
+		sb.WriteString(e.Abbr)
+		sb.WriteString(warekiNum(year, flag))
+		return
+	}
+	sb.WriteString(e.Name)
+	if year == 1 && flag.has(WarekiGannen) {
+		sb.WriteString("元")
+	} else {
+		sb.WriteString(warekiNum(year, flag))
+	}
+	sb.WriteString("年")
+}
+
+func formatWarekiYmd(sb *strings.Builder, ymd Ymd, flag WarekiFlag) bool {
+	e, year, ok := EraOf(ymd)
+	if !ok {
+		return false
+	}
+	_, m, d := ymd.Part()
+	formatWarekiYear(sb, e, year, flag)
+	if flag.has(WarekiAbbr) {
+		sb.WriteByte('.')
+		sb.WriteString(fillZero2(m))
+		sb.WriteByte('.')
+		sb.WriteString(fillZero2(d))
+		return true
+	}
+	sb.WriteString(warekiNum(m, flag))
+	sb.WriteString("月")
+	sb.WriteString(warekiNum(d, flag))
+	sb.WriteString("日")
+	return true
+}
+
+// FormatWareki 和暦でstring型に整形して変換します。該当する元号がない場合は空文字を返します
+//
+//	0             : 令和6年3月5日
+//	WarekiGannen  : 令和元年5月1日
+//	WarekiAbbr    : R6.03.05
+//	WarekiZeroPad : 令和06年03月05日
+func (ymd Ymd) FormatWareki(flag WarekiFlag) string {
+	sb := strings.Builder{}
+	if !formatWarekiYmd(&sb, ymd, flag) {
+		return ""
+	}
+	return sb.String()
+}
+
+// FormatWareki 和暦でstring型に整形して変換します。該当する元号がない場合は空文字を返します
+//
+//	元号の判定には月初日を使用します（2019年4月 → 平成31年4月、2019年5月 → 令和元年5月）
+func (ym Ym) FormatWareki(flag WarekiFlag) string {
+	if ym == 0 {
+		return ""
+	}
+	e, year, ok := EraOf(ym.First())
+	if !ok {
+		return ""
+	}
+	sb := strings.Builder{}
+	formatWarekiYear(&sb, e, year, flag)
+	if flag.has(WarekiAbbr) {
+		sb.WriteByte('.')
+		sb.WriteString(fillZero2(ym.Month()))
+		return sb.String()
+	}
+	sb.WriteString(warekiNum(ym.Month(), flag))
+	sb.WriteString("月")
+	return sb.String()
+}
+
+// FormatWareki 和暦でstring型に整形して変換します。該当する元号がない場合は空文字を返します
+//
+//	0          : 令和6年3月5日 10時30分15秒
+//	WarekiAbbr : R6.03.05 10:30:15
+func (yh Ymdhms) FormatWareki(flag WarekiFlag) string {
+	sb := strings.Builder{}
+	if !formatWarekiYmd(&sb, yh.Ymd(), flag) {
+		return ""
+	}
+	h, n, s := yh.Hms().Part()
+	sb.WriteByte(' ')
+	if flag.has(WarekiAbbr) {
+		sb.WriteString(yh.Hms().FormatHms(":", false))
+		return sb.String()
+	}
+	sb.WriteString(warekiNum(h, flag))
+	sb.WriteString("時")
+	sb.WriteString(warekiNum(n, flag))
+	sb.WriteString("分")
+	sb.WriteString(warekiNum(s, flag))
+	sb.WriteString("秒")
+	return sb.String()
+}
+
+// warekiScanner 和暦文字列の読み取り
+type warekiScanner struct {
+	s string
+}
+
+func (sc *warekiScanner) skipSpace() {
+	sc.s = strings.TrimLeftFunc(sc.s, unicode.IsSpace)
+}
+
+func (sc *warekiScanner) era(eras []Era) (Era, bool) {
+	sc.skipSpace()
+	for _, e := range eras {
+		if strings.HasPrefix(sc.s, e.Name) {
+			sc.s = sc.s[len(e.Name):]
+			return e, true
+		}
+	}
+	for _, e := range eras {
+		if e.Abbr != "" && len(sc.s) >= len(e.Abbr) && strings.EqualFold(sc.s[:len(e.Abbr)], e.Abbr) {
+			sc.s = sc.s[len(e.Abbr):]
+			return e, true
+		}
+	}
+	return Era{}, false
+}
+
+func (sc *warekiScanner) number(gannen bool) (int, bool) {
+	sc.skipSpace()
+	if gannen && strings.HasPrefix(sc.s, "元") {
+		sc.s = sc.s[len("元"):]
+		return 1, true
+	}
+	i := 0
+	for i < len(sc.s) && sc.s[i] >= '0' && sc.s[i] <= '9' {
+		i++
+	}
+	if i == 0 || i > 4 {
+		return 0, false
+	}
+	n, _ := strconv.Atoi(sc.s[:i])
+	sc.s = sc.s[i:]
+	return n, true
+}
+
+// sep 区切り文字を読み飛ばします。unitが指定された場合は単位の文字も区切りとして扱います
+func (sc *warekiScanner) sep(unit string) bool {
+	sc.skipSpace()
+	if unit != "" && strings.HasPrefix(sc.s, unit) {
+		sc.s = sc.s[len(unit):]
+		return true
+	}
+	if sc.s != "" && strings.IndexByte("./-", sc.s[0]) >= 0 {
+		sc.s = sc.s[1:]
+		return true
+	}
+	return false
+}
+
+func (sc *warekiScanner) end() bool {
+	sc.skipSpace()
+	return sc.s == ""
+}
+
+func parseWareki(s string, withDay bool) (e Era, next Ymd, y, m, d int, err error) {
+	_eraMu.RLock()
+	eras := _eras
+	_eraMu.RUnlock()
+
+	sc := warekiScanner{s: s}
+	var ok bool
+	if e, ok = sc.era(eras); !ok {
+		err = errors.Wrapf(ErrValidate, "unknown era : '%s'", s)
+		return
+	}
+	var ey int
+	if ey, ok = sc.number(true); !ok || ey < 1 || !sc.sep("年") {
+		err = errors.Wrapf(ErrValidate, "incorrect wareki year : '%s'", s)
+		return
+	}
+	if m, ok = sc.number(false); !ok {
+		err = errors.Wrapf(ErrValidate, "incorrect wareki month : '%s'", s)
+		return
+	}
+	if withDay {
+		if !sc.sep("月") {
+			err = errors.Wrapf(ErrValidate, "incorrect wareki month : '%s'", s)
+			return
+		}
+		if d, ok = sc.number(false); !ok {
+			err = errors.Wrapf(ErrValidate, "incorrect wareki day : '%s'", s)
+			return
+		}
+		sc.sep("日")
+	} else {
+		sc.sep("月")
+		d = 1
+	}
+	if !sc.end() {
+		err = errors.Wrapf(ErrValidate, "incorrect wareki text : '%s'", s)
+		return
+	}
+	y = e.Start.Year() + ey - 1
+	if i := eraIndex(eras, e.Start); i+1 < len(eras) {
+		next = eras[i+1].Start
+	}
+	return
+}
+
+// ParseWareki 和暦の文字列からYmd型に変換します
+//
+//	"令和6年3月5日", "令和元年5月1日", "R6.03.05", "h31/4/30" 等を読み取ります。
+//	元号の期間外の日付（平成31年5月1日等）はエラーになります
+func ParseWareki(s string) (ymd Ymd, err error) {
+	e, next, y, m, d, err := parseWareki(s, true)
+	if err != nil {
+		return
+	}
+	if !(m >= 1 && m <= 12 && d >= 1 && d <= LastDay(y, m)) {
+		return 0, errors.Wrapf(ErrValidate, "incorrect wareki date : '%s'", s)
+	}
+	ymd = Ymd(y*10000 + m*100 + d)
+	if ymd < e.Start || (next != 0 && ymd >= next) {
+		return 0, errors.Wrapf(ErrValidate, "out of era range : '%s'", s)
+	}
+	return
+}
+
+// ParseWarekiYm 和暦の文字列からYm型に変換します
+//
+//	"令和6年3月", "R6.03" 等を読み取ります
+func ParseWarekiYm(s string) (ym Ym, err error) {
+	e, next, y, m, _, err := parseWareki(s, false)
+	if err != nil {
+		return
+	}
+	if m < 1 || m > 12 {
+		return 0, errors.Wrapf(ErrValidate, "incorrect wareki month : '%s'", s)
+	}
+	ym = Ym(y*100 + m)
+	if ym.Last() < e.Start || (next != 0 && ym.First() >= next) {
+		return 0, errors.Wrapf(ErrValidate, "out of era range : '%s'", s)
+	}
+	return
+}
@@ -0,0 +1,172 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestYmdFormatWareki(t *testing.T) {
+	for _, x := range []struct {
+		ymd  Ymd
+		flag types.WarekiFlag
+		exp  string
+	}{
+		{20240305, 0, "令和6年3月5日"},
+		{20240305, types.WarekiAbbr, "R6.03.05"},
+		{20240305, types.WarekiZeroPad, "令和06年03月05日"},
+		{20240305, types.WarekiAbbr | types.WarekiZeroPad, "R06.03.05"},
+		{20190430, 0, "平成31年4月30日"},
+		{20190501, 0, "令和1年5月1日"},
+		{20190501, types.WarekiGannen, "令和元年5月1日"},
+		{20190501, types.WarekiGannen | types.WarekiAbbr, "R1.05.01"},
+		{19890107, types.WarekiGannen, "昭和64年1月7日"},
+		{19890108, types.WarekiGannen, "平成元年1月8日"},
+		{18680124, 0, ""},
+		{0, 0, ""},
+	} {
+		if act := x.ymd.FormatWareki(x.flag); x.exp != act {
+			t.Errorf("%v(%d): expect(%s) != actual(%s)", x.ymd, x.flag, x.exp, act)
+		}
+	}
+}
+
+func TestYmFormatWareki(t *testing.T) {
+	for _, x := range []struct {
+		ym   Ym
+		flag types.WarekiFlag
+		exp  string
+	}{
+		{202403, 0, "令和6年3月"},
+		{202403, types.WarekiAbbr, "R6.03"},
+		{201904, types.WarekiGannen, "平成31年4月"},
+		{201905, types.WarekiGannen, "令和元年5月"},
+		{0, 0, ""},
+	} {
+		if act := x.ym.FormatWareki(x.flag); x.exp != act {
+			t.Errorf("%v(%d): expect(%s) != actual(%s)", x.ym, x.flag, x.exp, act)
+		}
+	}
+}
+
+func TestYmdhmsFormatWareki(t *testing.T) {
+	for _, x := range []struct {
+		yh   types.Ymdhms
+		flag types.WarekiFlag
+		exp  string
+	}{
+		{20240305103015, 0, "令和6年3月5日 10時30分15秒"},
+		{20240305103015, types.WarekiAbbr, "R6.03.05 10:30:15"},
+		{20240305090105, types.WarekiZeroPad, "令和06年03月05日 09時01分05秒"},
+		{0, 0, ""},
+	} {
+		if act := x.yh.FormatWareki(x.flag); x.exp != act {
+			t.Errorf("%v(%d): expect(%s) != actual(%s)", x.yh, x.flag, x.exp, act)
+		}
+	}
+}
+
+func TestParseWareki(t *testing.T) {
+	for _, x := range []struct {
+		s   string
+		exp Ymd
+		ok  bool
+	}{
+		{"令和6年3月5日", 20240305, true},
+		{"令和元年5月1日", 20190501, true},
+		{"R6.03.05", 20240305, true},
+		{"r6.3.5", 20240305, true},
+		{"平成31年4月30日", 20190430, true},
+		{"H31/4/30", 20190430, true},
+		{" 昭和 64 年 1 月 7 日 ", 19890107, true},
+		{"平成31年5月1日", 0, false},
+		{"令和1年4月30日", 0, false},
+		{"令和6年2月30日", 0, false},
+		{"令和6年3月", 0, false},
+		{"令和6年3月5日x", 0, false},
+		{"X6.03.05", 0, false},
+		{"2024/03/05", 0, false},
+	} {
+		act, err := types.ParseWareki(x.s)
+		if x.ok && err != nil {
+			t.Errorf("%s: %+v", x.s, err)
+		} else if !x.ok && err == nil {
+			t.Errorf("%s: must be error", x.s)
+		} else if act != x.exp {
+			t.Errorf("%s: expect(%v) != actual(%v)", x.s, x.exp, act)
+		}
+	}
+}
+
+func TestParseWarekiYm(t *testing.T) {
+	for _, x := range []struct {
+		s   string
+		exp Ym
+		ok  bool
+	}{
+		{"令和6年3月", 202403, true},
+		{"R6.03", 202403, true},
+		{"令和元年5月", 201905, true},
+		{"平成31年4月", 201904, true},
+		{"令和元年4月", 0, false},
+		{"平成31年5月", 0, false},
+		{"令和6年13月", 0, false},
+	} {
+		act, err := types.ParseWarekiYm(x.s)
+		if x.ok && err != nil {
+			t.Errorf("%s: %+v", x.s, err)
+		} else if !x.ok && err == nil {
+			t.Errorf("%s: must be error", x.s)
+		} else if act != x.exp {
+			t.Errorf("%s: expect(%v) != actual(%v)", x.s, x.exp, act)
+		}
+	}
+}
+
+func TestScanWareki(t *testing.T) {
+	if ymd, err := types.ParseYmd("令和6年3月5日"); err != nil {
+		t.Errorf("%+v", err)
+	} else if ymd != 20240305 {
+		t.Errorf("expect(20240305) != actual(%v)", ymd)
+	}
+	if ym, err := types.ParseYm("R6.03"); err != nil {
+		t.Errorf("%+v", err)
+	} else if ym != 202403 {
+		t.Errorf("expect(202403) != actual(%v)", ym)
+	}
+}
+
+func TestAddEra(t *testing.T) {
+	org := types.Eras()
+	defer func() {
+		if err := types.SetEras(org); err != nil {
+			t.Errorf("%+v", err)
+		}
+	}()
+
+	if err := types.AddEra(types.Era{Name: "新元", Abbr: "N", Start: 20990101}); err != nil {
+		t.Errorf("%+v", err)
+		return
+	}
+	if act := Ymd(20990101).FormatWareki(types.WarekiGannen); act != "新元元年1月1日" {
+		t.Errorf("expect(新元元年1月1日) != actual(%s)", act)
+	}
+	if act := Ymd(20981231).FormatWareki(0); act != "令和80年12月31日" {
+		t.Errorf("expect(令和80年12月31日) != actual(%s)", act)
+	}
+	if _, err := types.ParseWareki("令和81年1月1日"); err == nil {
+		t.Error("令和81年1月1日 must be error")
+	}
+	if ymd, err := types.ParseWareki("N2.01.01"); err != nil {
+		t.Errorf("%+v", err)
+	} else if ymd != 21000101 {
+		t.Errorf("expect(21000101) != actual(%v)", ymd)
+	}
+
+	if err := types.AddEra(types.Era{Name: "重複", Start: 20990101}); err == nil {
+		t.Error("duplicate start must be error")
+	}
+	if err := types.AddEra(types.Era{Name: "不正", Start: 20990230}); err == nil {
+		t.Error("incorrect start must be error")
+	}
+}
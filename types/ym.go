@@ -19,7 +19,7 @@ type (
 	Ym int
 
 	//YmSlice Ym型のスライス
-	YmSlice []Ym
+	YmSlice = Slice[Ym]
 )
 
 // ErrValidate 値が適切でない
@@ -28,10 +28,6 @@ var ErrValidate = goerr.New("validate error")
 // ErrUnkownType 知らない型が指定されました
 var ErrUnkownType = goerr.New("unkown type")
 
-func (ym YmSlice) Len() int           { return len(ym) }
-func (ym YmSlice) Less(i, j int) bool { return ym[i] < ym[j] }
-func (ym YmSlice) Swap(i, j int)      { ym[i], ym[j] = ym[j], ym[i] }
-
 // ToYm 年月からYm型に変換します
 func ToYm(y, m int) (ym Ym, err error) {
 	_, err = ValidateYm(y, m)
@@ -44,11 +40,7 @@ func ToYm(y, m int) (ym Ym, err error) {
 
 // ParseYm Ym型に変換します
 func ParseYm(i interface{}) (ym Ym, err error) {
-	err = ym.Scan(i)
-	if err == nil {
-		_, err = ym.Validate()
-	}
-	return
+	return ParseYmWith(nil, i)
 }
 
 // ParseYm2 Ym型に変換します
@@ -91,12 +83,7 @@ func (ym Ym) FormatYm(sep string, zeroSuppress bool) string {
 
 // Validate 年月が正しいか確認します
 func (ym Ym) Validate() (bool, error) {
-	if ym == 0 {
-		return true, nil
-	}
-
-	y, m := ym.Part()
-	return ValidateYm(y, m)
+	return ym.ValidateWith(nil)
 }
 
 // Year 年を取得します
@@ -157,12 +144,9 @@ func (ym Ym) Ymd(d int) Ymd {
 	return Ymd(xy*10000 + xm*100 + d)
 }
 
-// GoTime go言語のTime型を取得します
-func (ym Ym) GoTime() (tm time.Time) {
-	if ym != 0 {
-		tm = time.Date(ym.Year(), time.Month(ym.Month()), 1, 0, 0, 0, 0, time.Local)
-	}
-	return
+// GoTime go言語のTime型（既定のタイムゾーン）を取得します
+func (ym Ym) GoTime() time.Time {
+	return ym.GoTimeIn(nil)
 }
 
 // Term From～To
@@ -207,11 +191,17 @@ func (ym Ym) BetweenMonth(m1, m2 int) bool {
 }
 
 // Scan 年月を読み取ります
+//
+//	書式に一致しない文字列は寛容に読み取ります（"2024年3月", "２０２４/０３", "2024.3" 等）
 func (ym *Ym) Scan(i interface{}) (err error) {
 	if conv.IsEmpty(i) {
 		*ym = 0
 		return nil
 	}
+	if p, ok := i.(DateParts); ok {
+		*ym = p.Ym()
+		return nil
+	}
 	if tm, ok := i.(time.Time); ok {
 		*ym = Ym(tm.Year()*100 + int(tm.Month()))
 		return nil
@@ -231,6 +221,15 @@ func (ym *Ym) Scan(i interface{}) (err error) {
 				return nil
 			}
 		}
+		if x, err := ParseWarekiYm(s); err == nil {
+			*ym = x
+			return nil
+		}
+		x, err := scanLenient(s, lenientYm, DateParts.Ym)
+		if err == nil {
+			*ym = x
+		}
+		return err
 	}
 	return errors.WithStack(ErrValidate)
 }
@@ -257,13 +256,15 @@ func (ym *Ym) UnmarshalJSON(b []byte) (err error) {
 	return
 }
 
-// MarshalJSON json.Marshalerの実装
-func (ym *Ym) MarshalJSON() ([]byte, error) {
-	return json.Marshal(int(*ym))
+// MarshalJSON json.Marshalerの実装（表現はSetJSONFormatで変更できます）
+func (ym Ym) MarshalJSON() ([]byte, error) {
+	return marshalJSON(ym, JSONNumber, ym.ISOString)
 }
 
-// MarshalLogObject zapcore.ObjectMarshalerの実装
-func (ym *Ym) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+// MarshalLogObject zapcore.ObjectMarshalerの実装（{"ym":"202403"}）
+//
+//	LogValueと同じ文字列の形式で出力する場合はzapx.Ymを使用します
+func (ym Ym) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("ym", ym.String())
 	return nil
 }
@@ -18,13 +18,9 @@ type (
 	Ymd int
 
 	//YmdSlice Ymd型のスライス
-	YmdSlice []Ymd
+	YmdSlice = Slice[Ymd]
 )
 
-func (ymd YmdSlice) Len() int           { return len(ymd) }
-func (ymd YmdSlice) Less(i, j int) bool { return ymd[i] < ymd[j] }
-func (ymd YmdSlice) Swap(i, j int)      { ymd[i], ymd[j] = ymd[j], ymd[i] }
-
 // ToYmd 年月日からYmd型に変換します
 func ToYmd(y, m, d int) (ymd Ymd, err error) {
 	_, err = ValidateYmd(y, m, d)
@@ -36,15 +32,7 @@ func ToYmd(y, m, d int) (ymd Ymd, err error) {
 
 // ParseYmd Ymd型に変換します
 func ParseYmd(i interface{}) (ymd Ymd, err error) {
-	if err = ymd.Scan(i); err != nil {
-		return
-	}
-	// 9999年99月99日はNEXTで使用しているので例外的に許可する
-	if ymd == 99999999 {
-		return
-	}
-	_, err = ymd.Validate()
-	return
+	return ParseYmdWith(nil, i)
 }
 
 // ParseYmd2 Ymd型に変換します
@@ -57,10 +45,9 @@ func ParseYmd2(i interface{}, err *error) (ymd Ymd) {
 	return
 }
 
-// YmdNow 現在の日付（ローカル）を取得します
+// YmdNow 現在の日付（既定のタイムゾーン）を取得します
 func YmdNow() Ymd {
-	t := time.Now()
-	return Ymd(t.Year()*10000 + int(t.Month())*100 + t.Day())
+	return YmdNowIn(nil)
 }
 
 // String string型変換
@@ -104,11 +91,17 @@ func (ymd Ymd) FormatYmd(sep string, zeroSuppress bool) string {
 }
 
 // Scan 年月日を読み取ります
+//
+//	書式に一致しない文字列は寛容に読み取ります（"2024年3月5日", "２０２４/０３/０５", "2024.3.5" 等）
 func (ymd *Ymd) Scan(i interface{}) error {
 	if conv.IsEmpty(i) {
 		*ymd = 0
 		return nil
 	}
+	if p, ok := i.(DateParts); ok {
+		*ymd = p.Ymd()
+		return nil
+	}
 
 	if tm, ok := i.(time.Time); ok {
 		*ymd = Ymd(tm.Year()*10000 + int(tm.Month())*100 + tm.Day())
@@ -129,6 +122,15 @@ func (ymd *Ymd) Scan(i interface{}) error {
 				return nil
 			}
 		}
+		if x, err := ParseWareki(s); err == nil {
+			*ymd = x
+			return nil
+		}
+		x, err := scanLenient(s, lenientYmd, DateParts.Ymd)
+		if err == nil {
+			*ymd = x
+		}
+		return err
 	}
 	return errors.WithStack(ErrValidate)
 }
@@ -155,13 +157,15 @@ func (ymd *Ymd) UnmarshalJSON(b []byte) (err error) {
 	return
 }
 
-// MarshalJSON json.Marshalerの実装
-func (ymd *Ymd) MarshalJSON() ([]byte, error) {
-	return json.Marshal(int(*ymd))
+// MarshalJSON json.Marshalerの実装（表現はSetJSONFormatで変更できます）
+func (ymd Ymd) MarshalJSON() ([]byte, error) {
+	return marshalJSON(ymd, JSONNumber, ymd.ISOString)
 }
 
-// MarshalLogObject zapcore.ObjectMarshalerの実装
-func (ymd *Ymd) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+// MarshalLogObject zapcore.ObjectMarshalerの実装（{"ymd":"20240305"}）
+//
+//	LogValueと同じ文字列の形式で出力する場合はzapx.Ymdを使用します
+func (ymd Ymd) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("ymd", ymd.String())
 	return nil
 }
@@ -171,8 +175,7 @@ func (ymd Ymd) Validate() (bool, error) {
 	if ymd == 0 {
 		return true, nil
 	}
-	y, m, d := ymd.Part()
-	return ValidateYmd(y, m, d)
+	return ymd.ValidateWith(nil)
 }
 
 // Part 年月日の要素を取得します
@@ -191,14 +194,9 @@ func (ymd Ymd) Parts() []int {
 	return v
 }
 
-// GoTime go言語のTime型に変換します
-func (ymd Ymd) GoTime() (tm time.Time) {
-	if ymd != 0 {
-		y, m, d := ymd.Part()
-		y, m, d = AdjustDay(y, m, d)
-		tm = time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.Local)
-	}
-	return
+// GoTime go言語のTime型（既定のタイムゾーン）に変換します
+func (ymd Ymd) GoTime() time.Time {
+	return ymd.GoTimeIn(nil)
 }
 
 // LastDay 最終日を取得します
@@ -225,15 +223,14 @@ func (ymd Ymd) Add(y, m, d int) Ymd {
 	// 元の日付を正しい値に修正します
 	year, month, day = AdjustDay(year, month, day)
 
+	year += y
 	if m != 0 {
 		year, month = AdjustMonth(year, month+m)
-		// 日が月末を超える場合は月末をセットします
-		if maxDay := LastDay(year, month); day > maxDay {
-			day = maxDay
-		}
 	}
-
-	year += y
+	// 日が月末を超える場合は月末をセットします（2/29の1年後は2/28）
+	if maxDay := LastDay(year, month); day > maxDay {
+		day = maxDay
+	}
 
 	//日の計算を行います
 	if d != 0 {
@@ -147,6 +147,10 @@ func TestYmdAdd(t *testing.T) {
 		{20210228, 0, -1, 0, 20210128, "月-1"},
 		{20210301, 0, -1, 30, 20210303, "月初から-1か月、日を足して日が月末越えした場合"},
 		{20210301, 0, 0, 375, 20220311, "月初から365+10日"},
+		{20240229, 1, 0, 0, 20250228, "閏日の1年後"},
+		{20240229, -4, 0, 0, 20200229, "閏日の4年前"},
+		{20240229, 1, 1, 0, 20250329, "閏日の1年1か月後"},
+		{20240131, 1, 1, 0, 20250228, "1/31の1年1か月後"},
 	} {
 		if ymd := x.ymd.Add(x.y, x.m, x.d); ymd != x.exp {
 			t.Errorf("%v.Add(%d,%d,%d), expect=%v, actual=%v: %s", x.ymd, x.y, x.m, x.d, x.exp, ymd, x.comment)
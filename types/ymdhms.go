@@ -11,18 +11,16 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
+const secondsPerDay = 24 * 60 * 60
+
 type (
 	// Ymdhms yyyyMMdd形式で年月日時分秒を表す整数型
 	Ymdhms int64
 
 	//YmdhmsSlice Ymdhms型のスライス
-	YmdhmsSlice []Ymdhms
+	YmdhmsSlice = Slice[Ymdhms]
 )
 
-func (yh YmdhmsSlice) Len() int           { return len(yh) }
-func (yh YmdhmsSlice) Less(i, j int) bool { return yh[i] < yh[j] }
-func (yh YmdhmsSlice) Swap(i, j int)      { yh[i], yh[j] = yh[j], yh[i] }
-
 // ToYmdhms 年月日時分秒からYmdhms型に変換します
 func ToYmdhms(y, m, d, h, n, s int) (yh Ymdhms, err error) {
 	_, err = ValidateYmdhms(y, m, d, h, n, s)
@@ -34,11 +32,7 @@ func ToYmdhms(y, m, d, h, n, s int) (yh Ymdhms, err error) {
 
 // ParseYmdhms Ymdhms型に変換します
 func ParseYmdhms(i interface{}) (yh Ymdhms, err error) {
-	if err = yh.Scan(i); err != nil {
-		return
-	}
-	_, err = yh.Validate()
-	return
+	return ParseYmdhmsWith(nil, i)
 }
 
 // ParseYmdhms2 Ymdhms型に変換します
@@ -52,13 +46,15 @@ func ParseYmdhms2(i interface{}, err *error) (yh Ymdhms) {
 }
 
 // YmdhmsFromGoTime time.TimeからYmdhmsに変換します
+//
+//	time.Timeが持つタイムゾーンの日時をそのまま使用します。変換先のタイムゾーンを指定する場合はYmdhmsFromGoTimeInを使用してください
 func YmdhmsFromGoTime(tm time.Time) Ymdhms {
 	return Ymdhms(((((int64(tm.Year())*100+int64(tm.Month()))*100+int64(tm.Day()))*100+int64(tm.Hour()))*100+int64(tm.Minute()))*100 + int64(tm.Second()))
 }
 
-// YmdhmsNow 現在の日付（ローカル）を取得します
+// YmdhmsNow 現在の日時（既定のタイムゾーン）を取得します
 func YmdhmsNow() Ymdhms {
-	return YmdhmsFromGoTime(time.Now())
+	return YmdhmsNowIn(nil)
 }
 
 // String string型変換
@@ -86,11 +82,21 @@ func (yh Ymdhms) FormatYmdhms(sep, sep2 string, zeroSuppress bool) string {
 }
 
 // Scan 年月日時分秒を読み取ります
+//
+//	書式に一致しない文字列は寛容に読み取ります（"2024年3月5日 10時30分", "2024.3.5 10:30" 等、時刻がない場合は0時）
 func (yh *Ymdhms) Scan(i interface{}) error {
 	if conv.IsEmpty(i) {
 		*yh = 0
 		return nil
 	}
+	if p, ok := i.(DateParts); ok {
+		*yh = p.Ymdhms()
+		return nil
+	}
+	if x, ok := i.(YmdhmsMs); ok {
+		*yh = x.Ymdhms()
+		return nil
+	}
 
 	if tm, ok := i.(time.Time); ok {
 		*yh = YmdhmsFromGoTime(tm)
@@ -105,12 +111,17 @@ func (yh *Ymdhms) Scan(i interface{}) error {
 		return nil
 	}
 	if s, ok := i.(string); ok {
-		for _, layout := range []string{"2006-01-02 15:04:05", "2006/01/02 15:04:05", "2006-1-2 15:04:05", "2006/1/2 15:04:05"} {
+		for _, layout := range []string{"2006-01-02 15:04:05", "2006/01/02 15:04:05", "2006-1-2 15:04:05", "2006/1/2 15:04:05", "2006-01-02T15:04:05"} {
 			if tm, err := time.Parse(layout, s); err == nil {
 				*yh = YmdhmsFromGoTime(tm)
 				return nil
 			}
 		}
+		x, err := scanLenient(s, lenientYmdhms, DateParts.Ymdhms)
+		if err == nil {
+			*yh = x
+		}
+		return err
 	}
 	return errors.WithStack(ErrValidate)
 }
@@ -137,32 +148,28 @@ func (yh *Ymdhms) UnmarshalJSON(b []byte) (err error) {
 	return
 }
 
-// MarshalJSON json.Marshalerの実装
-func (yh *Ymdhms) MarshalJSON() ([]byte, error) {
-	return json.Marshal(yh.String())
+// MarshalJSON json.Marshalerの実装（表現はSetJSONFormatで変更できます）
+func (yh Ymdhms) MarshalJSON() ([]byte, error) {
+	return marshalJSON(yh, JSONString, yh.ISOString)
 }
 
-// MarshalLogObject zapcore.ObjectMarshalerの実装
-func (yh *Ymdhms) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+// MarshalLogObject zapcore.ObjectMarshalerの実装（{"ymdhms":"20240305103015"}）
+//
+//	LogValueと同じ文字列の形式で出力する場合はzapx.Ymdhmsを使用します
+func (yh Ymdhms) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("ymdhms", yh.String())
 	return nil
 }
 
 // Validate 年月日時分秒が正しいか確認します
 func (yh Ymdhms) Validate() (bool, error) {
-	if yh == 0 {
-		return true, nil
-	}
-	y, m, d, h, n, s := yh.Part()
-	return ValidateYmdhms(y, m, d, h, n, s)
+	return yh.ValidateWith(nil)
 }
 
 // Part 年月日時分秒の要素を取得します
 func (yh Ymdhms) Part() (y, m, d, h, n, s int) {
-	d = int(yh) % 100
-	x := int(yh) / 100
-	m = x % 100
-	y = x / 100
+	y, m, d = yh.Ymd().Part()
+	h, n, s = yh.Hms().Part()
 	return
 }
 
@@ -173,19 +180,9 @@ func (yh Ymdhms) Parts() []int {
 	return v
 }
 
-// GoTime go言語のTime型に変換します
-func (yh Ymdhms) GoTime() (tm time.Time) {
-	if yh != 0 {
-		y, m, d, h, n, s := yh.Part()
-		h, n, s = AdjustHms(h, n, s)
-		if h > 23 {
-			d += h / 24
-			h %= 24
-		}
-		y, m, d = AdjustDay(y, m, d)
-		tm = time.Date(y, time.Month(m), d, h, n, s, 0, time.Local)
-	}
-	return
+// GoTime go言語のTime型（既定のタイムゾーン）に変換します
+func (yh Ymdhms) GoTime() time.Time {
+	return yh.GoTimeIn(nil)
 }
 
 // Add 年、月、日、時、分、秒を加算します（減算はマイナス値を引数にセットします）
@@ -194,17 +191,16 @@ func (yh Ymdhms) Add(y, m, d, h, n, s int) Ymdhms {
 		return 0
 	}
 
-	h, n, s = yh.Hms().Add(h, n, s).Part()
-	if h < 0 {
-		h = abs(h)
-		d -= (h / 24) + 1
-		h = 24 - h%24
-	} else if h > 23 {
-		d += h / 24
-		h %= 24
+	xh, xn, xs := yh.Hms().Part()
+	secs := ((xh+h)*60+xn+n)*60 + xs + s
+	dd := secs / secondsPerDay
+	if secs %= secondsPerDay; secs < 0 {
+		secs += secondsPerDay
+		dd--
 	}
+	h, n, s = secs/3600, (secs/60)%60, secs%60
 
-	y, m, d = yh.Ymd().Add(y, m, d).Part()
+	y, m, d = yh.Ymd().Add(y, m, d+dd).Part()
 
 	return Ymdhms(((((int64(y)*100+int64(m))*100+int64(d))*100+int64(h))*100+int64(n))*100 + int64(s))
 }
@@ -390,3 +386,11 @@ func (yh Ymdhms) TermYear(start Md) int {
 	}
 	return y
 }
+
+// StartOfHour 時単位に切り捨てた日時を取得します（時間ごとの集計のキー）
+func (yh Ymdhms) StartOfHour() Ymdhms {
+	if yh == 0 {
+		return 0
+	}
+	return yh / 10000 * 10000
+}
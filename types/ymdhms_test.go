@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestYmdhmsPart(t *testing.T) {
+	y, m, d, h, n, s := types.Ymdhms(20240305103015).Part()
+	if y != 2024 || m != 3 || d != 5 || h != 10 || n != 30 || s != 15 {
+		t.Errorf("%d,%d,%d,%d,%d,%d", y, m, d, h, n, s)
+	}
+}
+
+func TestYmdhmsAdd(t *testing.T) {
+	for _, x := range []struct {
+		yh               types.Ymdhms
+		y, m, d, h, n, s int
+		exp              types.Ymdhms
+		comment          string
+	}{
+		{20240101000000, 0, 0, 0, 0, 0, 1, 20240101000001, "0時からの加算"},
+		{20240101235959, 0, 0, 0, 0, 0, 1, 20240102000000, "日跨ぎ"},
+		{20240101000000, 0, 0, 0, 0, 0, -1, 20231231235959, "年跨ぎの減算"},
+		{20240101120000, 0, 0, 0, -24, 0, 0, 20231231120000, "-24時間"},
+		{20240101120000, 0, 0, 0, -36, 0, 0, 20231231000000, "-36時間"},
+		{20240101120000, 0, 0, 0, 36, 0, 0, 20240103000000, "+36時間"},
+		{20240101120000, 0, 0, 0, 1, 30, 0, 20240101133000, "時分の加算"},
+		{20240131120000, 0, 1, 0, 0, 0, 0, 20240229120000, "月末の月加算"},
+		{0, 0, 0, 0, 0, 0, 1, 0, "0はそのまま"},
+	} {
+		if act := x.yh.Add(x.y, x.m, x.d, x.h, x.n, x.s); act != x.exp {
+			t.Errorf("%v: expect(%v) != actual(%v): %s", x.yh, x.exp, act, x.comment)
+		}
+	}
+}
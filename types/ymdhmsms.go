@@ -0,0 +1,332 @@
+package types
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/MineTakaki/go-utils/conv"
+	"github.com/MineTakaki/go-utils/errors"
+	"go.uber.org/zap/zapcore"
+)
+
+type (
+	// YmdhmsMs yyyyMMddHHmmssSSS形式で年月日時分秒ミリ秒を表す整数型
+	//
+	//	マイクロ秒以下はint64の範囲に収まらないため扱いません
+	YmdhmsMs int64
+
+	//YmdhmsMsSlice YmdhmsMs型のスライス
+	YmdhmsMsSlice = Slice[YmdhmsMs]
+)
+
+// ToYmdhmsMs 年月日時分秒ミリ秒からYmdhmsMs型に変換します
+func ToYmdhmsMs(y, m, d, h, n, s, ms int) (x YmdhmsMs, err error) {
+	var yh Ymdhms
+	if yh, err = ToYmdhms(y, m, d, h, n, s); err != nil {
+		return
+	}
+	if ms < 0 || ms > 999 {
+		return 0, errors.Wrapf(ErrValidate, "incorrect millisecond value. ms:%d", ms)
+	}
+	return yh.YmdhmsMs().SetMillisecond(ms), nil
+}
+
+// ParseYmdhmsMs YmdhmsMs型に変換します
+func ParseYmdhmsMs(i interface{}) (x YmdhmsMs, err error) {
+	return ParseYmdhmsMsWith(nil, i)
+}
+
+// ParseYmdhmsMs2 YmdhmsMs型に変換します
+func ParseYmdhmsMs2(i interface{}, err *error) (x YmdhmsMs) {
+	var e error
+	x, e = ParseYmdhmsMs(i)
+	if err != nil {
+		*err = e
+	}
+	return
+}
+
+// YmdhmsMsFromGoTime time.TimeからYmdhmsMsに変換します（ミリ秒未満は切り捨てます）
+//
+//	time.Timeが持つタイムゾーンの日時をそのまま使用します
+func YmdhmsMsFromGoTime(tm time.Time) YmdhmsMs {
+	return YmdhmsFromGoTime(tm).YmdhmsMs().SetMillisecond(tm.Nanosecond() / int(time.Millisecond))
+}
+
+// YmdhmsMsFromGoTimeIn time.Timeを指定したタイムゾーンの日時に変換します（nilの場合は既定のタイムゾーン）
+func YmdhmsMsFromGoTimeIn(tm time.Time, loc *time.Location) YmdhmsMs {
+	return YmdhmsMsFromGoTime(tm.In(locationOrDefault(loc)))
+}
+
+// YmdhmsMsNow 現在の日時（既定のタイムゾーン）を取得します
+func YmdhmsMsNow() YmdhmsMs {
+	return YmdhmsMsNowIn(nil)
+}
+
+// YmdhmsMsNowIn 指定したタイムゾーンの現在の日時を取得します（nilの場合は既定のタイムゾーン）
+func YmdhmsMsNowIn(loc *time.Location) YmdhmsMs {
+	return YmdhmsMsFromGoTimeIn(time.Now(), loc)
+}
+
+// String string型変換
+func (x YmdhmsMs) String() string {
+	if x == 0 {
+		return ""
+	}
+	return ItoaZeroFilled64(int64(x), 17)
+}
+
+// CsvFormat CSV出力用のstring型変換
+func (x YmdhmsMs) CsvFormat() string {
+	if x == 0 {
+		return ""
+	}
+	return strconv.FormatInt(int64(x), 10)
+}
+
+// FormatYmdhmsMs string型に整形して変換します（2024/03/05 10:30:15.123）
+func (x YmdhmsMs) FormatYmdhmsMs(sep, sep2 string, zeroSuppress bool) string {
+	if x == 0 {
+		return ""
+	}
+	return x.Ymdhms().FormatYmdhms(sep, sep2, zeroSuppress) + "." + ZeroPrefix(strconv.Itoa(x.Millisecond()), 3)
+}
+
+// Format レイアウト文字列で整形します（書式はYmd.Formatを参照、SSSでミリ秒）
+func (x YmdhmsMs) Format(layout string) string {
+	if x == 0 {
+		return ""
+	}
+	y, m, d, h, n, s, ms := x.Part()
+	return DateParts{
+		Year: y, Month: m, Day: d, Hour: h, Minute: n, Second: s, Millisecond: ms,
+		HasYear: true, HasMonth: true, HasDay: true, HasTime: true,
+	}.Format(layout)
+}
+
+// Scan 年月日時分秒ミリ秒を読み取ります
+//
+//	書式に一致しない文字列はYmdhms.Scanと同様に寛容に読み取ります
+func (x *YmdhmsMs) Scan(i interface{}) error {
+	if conv.IsEmpty(i) {
+		*x = 0
+		return nil
+	}
+	switch v := i.(type) {
+	case DateParts:
+		*x = v.Ymdhms().YmdhmsMs().SetMillisecond(v.Millisecond)
+		return nil
+	case time.Time:
+		*x = YmdhmsMsFromGoTime(v)
+		return nil
+	case *time.Time:
+		*x = YmdhmsMsFromGoTime(*v)
+		return nil
+	case Ymdhms:
+		*x = v.YmdhmsMs()
+		return nil
+	}
+	if n, ok := conv.Int64(i); ok {
+		*x = YmdhmsMs(n)
+		return nil
+	}
+	if s, ok := i.(string); ok {
+		// time.Parseは秒の後の小数部をレイアウトの指定がなくても読み取ります
+		for _, layout := range []string{"2006-01-02 15:04:05", "2006/01/02 15:04:05", "2006-1-2 15:04:05", "2006/1/2 15:04:05", "2006-01-02T15:04:05"} {
+			if tm, err := time.Parse(layout, s); err == nil {
+				*x = YmdhmsMsFromGoTime(tm)
+				return nil
+			}
+		}
+		v, err := scanLenient(s, lenientYmdhms, func(p DateParts) YmdhmsMs {
+			return p.Ymdhms().YmdhmsMs().SetMillisecond(p.Millisecond)
+		})
+		if err == nil {
+			*x = v
+		}
+		return err
+	}
+	return errors.WithStack(ErrValidate)
+}
+
+// Value driver.Valuerインターフェイスの実装
+func (x YmdhmsMs) Value() (driver.Value, error) {
+	if x == 0 {
+		return nil, nil
+	}
+	return int64(x), nil
+}
+
+// UnmarshalJSON json.Unmarshalerインターフェイスの実装
+//
+//	17桁の数値はfloat64では精度が足りないため、json.Numberとして読み取ります
+func (x *YmdhmsMs) UnmarshalJSON(b []byte) (err error) {
+	var s interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err = errors.WithStack(dec.Decode(&s)); err != nil {
+		return
+	}
+	var v YmdhmsMs
+	if v, err = ParseYmdhmsMs(s); err != nil {
+		return
+	}
+	*x = v
+	return
+}
+
+// MarshalJSON json.Marshalerの実装（表現はSetJSONFormatで変更できます）
+func (x YmdhmsMs) MarshalJSON() ([]byte, error) {
+	return marshalJSON(x, JSONString, x.ISOString)
+}
+
+// MarshalLogObject zapcore.ObjectMarshalerの実装（{"ymdhmsms":"20240305103015123"}）
+//
+//	LogValueと同じ文字列の形式で出力する場合はzapx.YmdhmsMsを使用します
+func (x YmdhmsMs) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("ymdhmsms", x.String())
+	return nil
+}
+
+// Validate 年月日時分秒ミリ秒が正しいか確認します
+func (x YmdhmsMs) Validate() (bool, error) {
+	return x.ValidateWith(nil)
+}
+
+// Part 年月日時分秒ミリ秒の要素を取得します
+func (x YmdhmsMs) Part() (y, m, d, h, n, s, ms int) {
+	y, m, d, h, n, s = x.Ymdhms().Part()
+	ms = x.Millisecond()
+	return
+}
+
+// Parts 年月日時分秒ミリ秒の要素を配列で取得します
+func (x YmdhmsMs) Parts() []int {
+	v := make([]int, 7)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6] = x.Part()
+	return v
+}
+
+// GoTime go言語のTime型（既定のタイムゾーン）に変換します
+func (x YmdhmsMs) GoTime() time.Time {
+	return x.GoTimeIn(nil)
+}
+
+// GoTimeIn 指定したタイムゾーンのtime.Timeに変換します（nilの場合は既定のタイムゾーン）
+//
+//	夏時間の切り替え時の扱いはYmdhms.GoTimeInと同じです
+func (x YmdhmsMs) GoTimeIn(loc *time.Location) (tm time.Time) {
+	if x != 0 {
+		tm = x.Ymdhms().GoTimeIn(loc).Add(time.Duration(x.Millisecond()) * time.Millisecond)
+	}
+	return
+}
+
+// Add 年、月、日、時、分、秒、ミリ秒を加算します（減算はマイナス値を引数にセットします）
+func (x YmdhmsMs) Add(y, m, d, h, n, s, ms int) YmdhmsMs {
+	if x == 0 {
+		return 0
+	}
+	ms += x.Millisecond()
+	ds := ms / 1000
+	if ms %= 1000; ms < 0 {
+		ms += 1000
+		ds--
+	}
+	return x.Ymdhms().Add(y, m, d, h, n, s+ds).YmdhmsMs().SetMillisecond(ms)
+}
+
+// AddDuration time.Durationを加算します（ミリ秒未満は切り捨てます）
+func (x YmdhmsMs) AddDuration(dur time.Duration) YmdhmsMs {
+	return x.Add(0, 0, 0, 0, 0, 0, int(dur/time.Millisecond))
+}
+
+// Prev 1ミリ秒前の値を取得します
+func (x YmdhmsMs) Prev() YmdhmsMs {
+	return x.Add(0, 0, 0, 0, 0, 0, -1)
+}
+
+// Next 1ミリ秒後の値を取得します
+func (x YmdhmsMs) Next() YmdhmsMs {
+	return x.Add(0, 0, 0, 0, 0, 0, 1)
+}
+
+// Ymdhms 年月日時分秒をYmdhms型の値で取得します（ミリ秒は切り捨てます）
+func (x YmdhmsMs) Ymdhms() Ymdhms {
+	if x == 0 {
+		return 0
+	}
+	return Ymdhms(int64(x) / 1000)
+}
+
+// Ymd 年月日をYmd型の値で取得します
+func (x YmdhmsMs) Ymd() Ymd {
+	return x.Ymdhms().Ymd()
+}
+
+// Hms 時分秒をHms型の値で取得します
+func (x YmdhmsMs) Hms() Hms {
+	return x.Ymdhms().Hms()
+}
+
+// Millisecond ミリ秒を取得します
+func (x YmdhmsMs) Millisecond() int {
+	return int(abs64(int64(x)) % 1000)
+}
+
+// SetMillisecond ミリ秒を指定した値で置き換えます
+func (x YmdhmsMs) SetMillisecond(ms int) YmdhmsMs {
+	if x == 0 {
+		return 0
+	}
+	return YmdhmsMs(int64(x)/1000*1000 + int64(ms%1000))
+}
+
+// YmdhmsMs YmdhmsMs型（ミリ秒は0）に変換します
+func (yh Ymdhms) YmdhmsMs() YmdhmsMs {
+	return YmdhmsMs(int64(yh) * 1000)
+}
+
+// Between 二つの日時の間に入るか判定します
+func (x YmdhmsMs) Between(f, t YmdhmsMs) bool {
+	if x == 0 || f == 0 || t == 0 {
+		return false
+	}
+	return f <= x && x <= t
+}
+
+// Min 指定した日時と比較して小さい値を返します
+func (x YmdhmsMs) Min(o YmdhmsMs) YmdhmsMs {
+	if x == 0 {
+		return o
+	}
+	if o != 0 && x > o {
+		return o
+	}
+	return x
+}
+
+// Max 指定した日時と比較して大きい値を返します
+func (x YmdhmsMs) Max(o YmdhmsMs) YmdhmsMs {
+	if x == 0 {
+		return o
+	}
+	if o != 0 && x < o {
+		return o
+	}
+	return x
+}
+
+// Compare YmdhmsMs同志を比較します
+func (x YmdhmsMs) Compare(o YmdhmsMs) int {
+	if x < o {
+		return -1
+	}
+	if x > o {
+		return 1
+	}
+	return 0
+}
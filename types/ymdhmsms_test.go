@@ -0,0 +1,127 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestYmdhmsMsPart(t *testing.T) {
+	y, m, d, h, n, s, ms := types.YmdhmsMs(20240305103015123).Part()
+	if y != 2024 || m != 3 || d != 5 || h != 10 || n != 30 || s != 15 || ms != 123 {
+		t.Errorf("%d,%d,%d,%d,%d,%d,%d", y, m, d, h, n, s, ms)
+	}
+}
+
+func TestYmdhmsMsAdd(t *testing.T) {
+	for _, x := range []struct {
+		v       types.YmdhmsMs
+		s, ms   int
+		exp     types.YmdhmsMs
+		comment string
+	}{
+		{20240101000000000, 0, 1, 20240101000000001, "0時からの加算"},
+		{20240101235959999, 0, 1, 20240102000000000, "日跨ぎ"},
+		{20240101000000000, 0, -1, 20231231235959999, "年跨ぎの減算"},
+		{20240101120000500, 0, -1500, 20240101115959000, "秒への繰り下がり"},
+		{20240101120000500, 1, 2600, 20240101120004100, "秒への繰り上がり"},
+		{0, 0, 1, 0, "0はそのまま"},
+	} {
+		if act := x.v.Add(0, 0, 0, 0, 0, x.s, x.ms); act != x.exp {
+			t.Errorf("%v: expect(%v) != actual(%v): %s", x.v, x.exp, act, x.comment)
+		}
+	}
+}
+
+func TestYmdhmsMsScan(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	for _, x := range []struct {
+		v   interface{}
+		exp types.YmdhmsMs
+	}{
+		{"2024-03-05 10:30:15.123", 20240305103015123},
+		{"2024/03/05 10:30:15", 20240305103015000},
+		{"2024-03-05T10:30:15.1", 20240305103015100},
+		{int64(20240305103015123), 20240305103015123},
+		{types.Ymdhms(20240305103015), 20240305103015000},
+		{time.Date(2024, 3, 5, 10, 30, 15, 123456789, jst), 20240305103015123},
+		{nil, 0},
+	} {
+		var act types.YmdhmsMs
+		if err := act.Scan(x.v); err != nil {
+			t.Errorf("%v: %+v", x.v, err)
+		} else if act != x.exp {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.v, x.exp, act)
+		}
+	}
+	if _, err := types.ParseYmdhmsMs("2024-13-05 10:30:15.123"); err == nil {
+		t.Errorf("error expected")
+	}
+}
+
+func TestYmdhmsMsConvert(t *testing.T) {
+	x := types.YmdhmsMs(20240305103015123)
+	if act := x.Ymdhms(); act != 20240305103015 {
+		t.Errorf("expect(%v) != actual(%v)", 20240305103015, act)
+	}
+	jst := time.FixedZone("JST", 9*60*60)
+	tm := x.GoTimeIn(jst)
+	if exp := time.Date(2024, 3, 5, 10, 30, 15, 123000000, jst); !tm.Equal(exp) {
+		t.Errorf("expect(%v) != actual(%v)", exp, tm)
+	}
+	if act := types.YmdhmsMsFromGoTimeIn(tm, time.UTC); act != 20240305013015123 {
+		t.Errorf("expect(%v) != actual(%v)", 20240305013015123, act)
+	}
+	if act := x.FormatYmdhmsMs("/", ":", false); act != "2024/03/05 10:30:15.123" {
+		t.Errorf("expect(%v) != actual(%v)", "2024/03/05 10:30:15.123", act)
+	}
+	if act := x.Format("yyyy-MM-dd HH:mm:ss.SS"); act != "2024-03-05 10:30:15.12" {
+		t.Errorf("expect(%v) != actual(%v)", "2024-03-05 10:30:15.12", act)
+	}
+	p, err := types.ParseLayout("yyyyMMddHHmmssS", "202403051030151")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var y types.YmdhmsMs
+	if err = y.Scan(p); err != nil || y != 20240305103015100 {
+		t.Errorf("expect(%v) != actual(%v) %v", 20240305103015100, y, err)
+	}
+}
+
+func TestYmdhmsMsJSON(t *testing.T) {
+	x := types.YmdhmsMs(20240305103015123)
+	b, err := json.Marshal(&x)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var act types.YmdhmsMs
+	if err = json.Unmarshal(b, &act); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if act != x {
+		t.Errorf("expect(%v) != actual(%v)", x, act)
+	}
+}
+
+func TestYmdhmsMsJSONNumber(t *testing.T) {
+	defer types.SetJSONFormat(types.JSONDefault)
+	types.SetJSONFormat(types.JSONNumber)
+
+	x := types.YmdhmsMs(20240305103015123)
+	b, err := json.Marshal(&x)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if string(b) != "20240305103015123" {
+		t.Errorf("expect(%v) != actual(%v)", "20240305103015123", string(b))
+	}
+	var act types.YmdhmsMs
+	if err = json.Unmarshal(b, &act); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if act != x {
+		t.Errorf("expect(%v) != actual(%v)", x, act)
+	}
+}
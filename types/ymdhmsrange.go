@@ -0,0 +1,264 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+
+	"github.com/MineTakaki/go-utils/conv"
+	"github.com/MineTakaki/go-utils/errors"
+	"go.uber.org/zap/zapcore"
+)
+
+// YmdhmsRange Ymdhms型の閉区間（From, Toを含む）
+//
+//	From, Toのいずれかが0、またはFrom > Toの場合は空の区間として扱います
+type YmdhmsRange struct {
+	From Ymdhms `json:"from"`
+	To   Ymdhms `json:"to"`
+}
+
+// NewYmdhmsRange 開始日時と終了日時から区間を生成します。from > to の場合は入れ替えます
+func NewYmdhmsRange(from, to Ymdhms) YmdhmsRange {
+	if from > to && to != 0 {
+		from, to = to, from
+	}
+	return YmdhmsRange{From: from, To: to}
+}
+
+func (r YmdhmsRange) span() span[Ymdhms] {
+	return span[Ymdhms]{r.From, r.To}
+}
+
+func ymdhmsRangeOf(s span[Ymdhms]) YmdhmsRange {
+	return YmdhmsRange{From: s.from, To: s.to}
+}
+
+func ymdhmsRangesOf(v []span[Ymdhms]) []YmdhmsRange {
+	x := make([]YmdhmsRange, len(v))
+	for i, s := range v {
+		x[i] = ymdhmsRangeOf(s)
+	}
+	return x
+}
+
+// IsEmpty 空の区間か判定します
+func (r YmdhmsRange) IsEmpty() bool {
+	return r.span().empty()
+}
+
+// String string型変換
+func (r YmdhmsRange) String() string {
+	if r.From == 0 && r.To == 0 {
+		return ""
+	}
+	return r.From.String() + "-" + r.To.String()
+}
+
+// Contains 日時が区間に含まれるか判定します
+func (r YmdhmsRange) Contains(yh Ymdhms) bool {
+	return r.span().contains(yh)
+}
+
+// Seconds 区間の秒数を取得します（From, Toを含むため同一日時の場合は1になります）
+func (r YmdhmsRange) Seconds() int64 {
+	if r.IsEmpty() {
+		return 0
+	}
+	sec := func(yh Ymdhms) int64 {
+		h, n, s := yh.Hms().Part()
+		return int64(yh.Days())*secondsPerDay + int64(h*3600+n*60+s)
+	}
+	return sec(r.To) - sec(r.From) + 1
+}
+
+// Days 区間に含まれる日数（日付の数）を取得します
+func (r YmdhmsRange) Days() int {
+	return r.YmdRange().Days()
+}
+
+// YmdRange 日付の区間に変換します
+func (r YmdhmsRange) YmdRange() YmdRange {
+	if r.IsEmpty() {
+		return YmdRange{}
+	}
+	return YmdRange{From: r.From.Ymd(), To: r.To.Ymd()}
+}
+
+// Step 開始日時から指定した間隔で区間内の日時を順に処理します。fnがfalseを返した場合は中断します
+//
+//	間隔が正でない（日時が進まない）場合は何も処理しません
+func (r YmdhmsRange) Step(y, m, d, h, n, s int, fn func(Ymdhms) bool) {
+	if r.IsEmpty() || r.From.Add(y, m, d, h, n, s) <= r.From {
+		return
+	}
+	prev := Ymdhms(0)
+	for i := 0; ; i++ {
+		yh := r.From.Add(y*i, m*i, d*i, h*i, n*i, s*i)
+		if yh <= prev || yh > r.To || !fn(yh) {
+			return
+		}
+		prev = yh
+	}
+}
+
+// EachDay 開始日時から1日ごとに処理します
+func (r YmdhmsRange) EachDay(fn func(Ymdhms) bool) {
+	r.Step(0, 0, 1, 0, 0, 0, fn)
+}
+
+// EachWeek 開始日時から1週間ごとに処理します
+func (r YmdhmsRange) EachWeek(fn func(Ymdhms) bool) {
+	r.Step(0, 0, 7, 0, 0, 0, fn)
+}
+
+// EachMonth 開始日時から1か月ごとに処理します
+func (r YmdhmsRange) EachMonth(fn func(Ymdhms) bool) {
+	r.Step(0, 1, 0, 0, 0, 0, fn)
+}
+
+// Overlaps 区間が重なるか判定します
+func (r YmdhmsRange) Overlaps(o YmdhmsRange) bool {
+	return r.span().overlaps(o.span())
+}
+
+// Intersect 重なる区間を取得します
+func (r YmdhmsRange) Intersect(o YmdhmsRange) (YmdhmsRange, bool) {
+	s, ok := r.span().intersect(o.span())
+	return ymdhmsRangeOf(s), ok
+}
+
+// Union 重なるか隣接する区間を結合します。結合できない場合はokがfalseになります
+func (r YmdhmsRange) Union(o YmdhmsRange) (YmdhmsRange, bool) {
+	s, ok := r.span().union(o.span())
+	return ymdhmsRangeOf(s), ok
+}
+
+// Subtract 区間から指定した区間を取り除いた区間を取得します
+func (r YmdhmsRange) Subtract(o YmdhmsRange) []YmdhmsRange {
+	return ymdhmsRangesOf(r.span().subtract(o.span()))
+}
+
+// SplitDays 日の区切りで区間を分割します
+func (r YmdhmsRange) SplitDays() []YmdhmsRange {
+	boundary := func(yh Ymdhms) Ymdhms {
+		return Ymdhms(int64(yh.Ymd().Next()) * 1000000)
+	}
+	return ymdhmsRangesOf(splitSpan(r.span(), boundary))
+}
+
+// SplitMonths 月の区切りで区間を分割します
+func (r YmdhmsRange) SplitMonths() []YmdhmsRange {
+	return r.SplitTerm(101, 1)
+}
+
+// SplitTerm 期の区切りで区間を分割します
+//
+//	startは期首の月日、monthsは1期の月数です（年度: 401, 12、四半期: 401, 3）
+func (r YmdhmsRange) SplitTerm(start Md, months int) []YmdhmsRange {
+	if months < 1 {
+		return []YmdhmsRange{r}
+	}
+	sm, sd := start.Part()
+	boundary := func(yh Ymdhms) Ymdhms {
+		base := Ym((yh.Year()-1)*100 + sm)
+		for i := 1; ; i++ {
+			if next := Ymdhms(int64(base.Add(0, months*i).Ymd(sd)) * 1000000); next > yh {
+				return next
+			}
+		}
+	}
+	return ymdhmsRangesOf(splitSpan(r.span(), boundary))
+}
+
+// NormalizeYmdhmsRanges 重なるか隣接する区間を結合して、開始日時の昇順に並べます（空の区間は除外します）
+func NormalizeYmdhmsRanges(v []YmdhmsRange) []YmdhmsRange {
+	x := make([]span[Ymdhms], len(v))
+	for i, r := range v {
+		x[i] = r.span()
+	}
+	return ymdhmsRangesOf(normalizeSpans(x))
+}
+
+// Scan 区間を読み取ります
+//
+//	日時の区切りに"-"を含む書式は","または"～"で区切ってください
+func (r *YmdhmsRange) Scan(i interface{}) (err error) {
+	if conv.IsEmpty(i) {
+		*r = YmdhmsRange{}
+		return nil
+	}
+	var s string
+	switch v := i.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return errors.Wrapf(ErrUnkownType, "%T", i)
+	}
+	f, t, exclusive, ok := splitRangeText(s)
+	if !ok {
+		return errors.Wrapf(ErrValidate, "incorrect range : '%s'", s)
+	}
+	var x YmdhmsRange
+	if x.From, err = ParseYmdhms(f); err != nil {
+		return
+	}
+	if x.To, err = ParseYmdhms(t); err != nil {
+		return
+	}
+	if exclusive {
+		x.To = x.To.Prev()
+	}
+	*r = x
+	return nil
+}
+
+// Value driver.Valuerインターフェイスの実装
+func (r YmdhmsRange) Value() (driver.Value, error) {
+	if r.From == 0 && r.To == 0 {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+// UnmarshalJSON json.Unmarshalerインターフェイスの実装
+func (r *YmdhmsRange) UnmarshalJSON(b []byte) (err error) {
+	if s := strings.TrimSpace(string(b)); s == "null" || s == `""` {
+		*r = YmdhmsRange{}
+		return nil
+	} else if strings.HasPrefix(s, `"`) {
+		var x string
+		if err = errors.WithStack(json.Unmarshal(b, &x)); err != nil {
+			return
+		}
+		return r.Scan(x)
+	}
+	var x struct {
+		From Ymdhms `json:"from"`
+		To   Ymdhms `json:"to"`
+	}
+	if err = errors.WithStack(json.Unmarshal(b, &x)); err != nil {
+		return
+	}
+	*r = YmdhmsRange(x)
+	return
+}
+
+// MarshalJSON json.Marshalerの実装
+func (r YmdhmsRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	}{r.From.String(), r.To.String()})
+}
+
+// MarshalLogObject zapcore.ObjectMarshalerの実装（{"from":"20240101090000","to":"20240101180000"}）
+//
+//	LogValueと同じ文字列の形式で出力する場合はzapx.YmdhmsRangeを使用します
+func (r YmdhmsRange) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("from", r.From.String())
+	enc.AddString("to", r.To.String())
+	return nil
+}
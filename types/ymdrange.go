@@ -0,0 +1,396 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/MineTakaki/go-utils/conv"
+	"github.com/MineTakaki/go-utils/errors"
+	"go.uber.org/zap/zapcore"
+)
+
+type (
+	// YmdRange Ymd型の閉区間（From, Toを含む）
+	//
+	//	From, Toのいずれかが0、またはFrom > Toの場合は空の区間として扱います
+	YmdRange struct {
+		From Ymd `json:"from"`
+		To   Ymd `json:"to"`
+	}
+
+	// discrete 前後の値が取得できる整数型の日付
+	discrete[T any] interface {
+		~int | ~int64
+		Next() T
+		Prev() T
+	}
+
+	// span 区間演算用の閉区間
+	span[T discrete[T]] struct {
+		from, to T
+	}
+)
+
+func (s span[T]) empty() bool {
+	return s.from == 0 || s.to == 0 || s.from > s.to
+}
+
+func (s span[T]) contains(v T) bool {
+	return !s.empty() && v != 0 && s.from <= v && v <= s.to
+}
+
+func (s span[T]) overlaps(o span[T]) bool {
+	return !s.empty() && !o.empty() && s.from <= o.to && o.from <= s.to
+}
+
+func (s span[T]) intersect(o span[T]) (span[T], bool) {
+	if !s.overlaps(o) {
+		return span[T]{}, false
+	}
+	x := s
+	if o.from > x.from {
+		x.from = o.from
+	}
+	if o.to < x.to {
+		x.to = o.to
+	}
+	return x, true
+}
+
+// adjacent 重なるか隣接しているか判定します
+func (s span[T]) adjacent(o span[T]) bool {
+	if s.empty() || o.empty() {
+		return false
+	}
+	return s.from <= o.to.Next() && o.from <= s.to.Next()
+}
+
+func (s span[T]) union(o span[T]) (span[T], bool) {
+	if !s.adjacent(o) {
+		return span[T]{}, false
+	}
+	x := s
+	if o.from < x.from {
+		x.from = o.from
+	}
+	if o.to > x.to {
+		x.to = o.to
+	}
+	return x, true
+}
+
+func (s span[T]) subtract(o span[T]) []span[T] {
+	if s.empty() {
+		return nil
+	}
+	if !s.overlaps(o) {
+		return []span[T]{s}
+	}
+	v := make([]span[T], 0, 2)
+	if s.from < o.from {
+		v = append(v, span[T]{s.from, o.from.Prev()})
+	}
+	if o.to < s.to {
+		v = append(v, span[T]{o.to.Next(), s.to})
+	}
+	return v
+}
+
+// normalizeSpans 空の区間を除外して、重なるか隣接する区間を結合します
+func normalizeSpans[T discrete[T]](v []span[T]) []span[T] {
+	x := make([]span[T], 0, len(v))
+	for _, s := range v {
+		if !s.empty() {
+			x = append(x, s)
+		}
+	}
+	sort.Slice(x, func(i, j int) bool { return x[i].from < x[j].from })
+	r := x[:0]
+	for _, s := range x {
+		if n := len(r); n > 0 {
+			if u, ok := r[n-1].union(s); ok {
+				r[n-1] = u
+				continue
+			}
+		}
+		r = append(r, s)
+	}
+	return r
+}
+
+// splitSpan boundaryで指定した区切りで区間を分割します
+//
+//	boundaryはvを含む区切りの次の区切りの開始値を返します
+func splitSpan[T discrete[T]](s span[T], boundary func(v T) T) []span[T] {
+	if s.empty() {
+		return nil
+	}
+	var v []span[T]
+	for from := s.from; from != 0 && from <= s.to; {
+		next := boundary(from)
+		if next == 0 || next > s.to {
+			v = append(v, span[T]{from, s.to})
+			break
+		}
+		v = append(v, span[T]{from, next.Prev()})
+		from = next
+	}
+	return v
+}
+
+// splitRangeText 区間の文字列を開始と終了に分割します
+//
+//	"20240101-20240131", "2024/01/01～2024/01/31", "[2024-01-01,2024-02-01)" 等を受け付けます。
+//	exclusiveは終了が開区間（")"）の場合にtrueになります
+func splitRangeText(s string) (from, to string, exclusive bool, ok bool) {
+	s = strings.TrimSpace(s)
+	if n := len(s); n >= 2 && (s[0] == '[' || s[0] == '(') {
+		if s[n-1] != ']' && s[n-1] != ')' {
+			return
+		}
+		exclusive = s[n-1] == ')'
+		s = s[1 : n-1]
+	}
+	for _, sep := range []string{",", "～", "~"} {
+		if i := strings.Index(s, sep); i >= 0 {
+			return strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+len(sep):]), exclusive, true
+		}
+	}
+	if strings.Count(s, "-") == 1 {
+		i := strings.IndexByte(s, '-')
+		return strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+1:]), exclusive, true
+	}
+	return
+}
+
+// NewYmdRange 開始日と終了日から区間を生成します。from > to の場合は入れ替えます
+func NewYmdRange(from, to Ymd) YmdRange {
+	if from > to && to != 0 {
+		from, to = to, from
+	}
+	return YmdRange{From: from, To: to}
+}
+
+func (r YmdRange) span() span[Ymd] {
+	return span[Ymd]{r.From, r.To}
+}
+
+func ymdRangeOf(s span[Ymd]) YmdRange {
+	return YmdRange{From: s.from, To: s.to}
+}
+
+func ymdRangesOf(v []span[Ymd]) []YmdRange {
+	x := make([]YmdRange, len(v))
+	for i, s := range v {
+		x[i] = ymdRangeOf(s)
+	}
+	return x
+}
+
+// IsEmpty 空の区間か判定します
+func (r YmdRange) IsEmpty() bool {
+	return r.span().empty()
+}
+
+// String string型変換
+func (r YmdRange) String() string {
+	if r.From == 0 && r.To == 0 {
+		return ""
+	}
+	return r.From.String() + "-" + r.To.String()
+}
+
+// Contains 日付が区間に含まれるか判定します
+func (r YmdRange) Contains(ymd Ymd) bool {
+	return r.span().contains(ymd)
+}
+
+// Days 区間の日数を取得します
+func (r YmdRange) Days() int {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.To.Days() - r.From.Days() + 1
+}
+
+// Step 開始日から指定した年月日の間隔で区間内の日付を順に処理します。fnがfalseを返した場合は中断します
+//
+//	月の加算で日が月末を超える場合は月末日になります（1/31 → 2/29 → 3/31）。
+//	間隔が正でない（日付が進まない）場合は何も処理しません
+func (r YmdRange) Step(y, m, d int, fn func(Ymd) bool) {
+	if r.IsEmpty() || r.From.Add(y, m, d) <= r.From {
+		return
+	}
+	prev := Ymd(0)
+	for i := 0; ; i++ {
+		ymd := r.From.Add(y*i, m*i, d*i)
+		if ymd <= prev || ymd > r.To || !fn(ymd) {
+			return
+		}
+		prev = ymd
+	}
+}
+
+// EachDay 区間内の日付を1日ずつ処理します
+func (r YmdRange) EachDay(fn func(Ymd) bool) {
+	r.Step(0, 0, 1, fn)
+}
+
+// EachWeek 開始日から1週間ごとに処理します
+func (r YmdRange) EachWeek(fn func(Ymd) bool) {
+	r.Step(0, 0, 7, fn)
+}
+
+// EachMonth 開始日から1か月ごとに処理します
+func (r YmdRange) EachMonth(fn func(Ymd) bool) {
+	r.Step(0, 1, 0, fn)
+}
+
+// Slice 区間内の日付をスライスで取得します
+func (r YmdRange) Slice() YmdSlice {
+	v := make(YmdSlice, 0, r.Days())
+	r.EachDay(func(ymd Ymd) bool {
+		v = append(v, ymd)
+		return true
+	})
+	return v
+}
+
+// Overlaps 区間が重なるか判定します
+func (r YmdRange) Overlaps(o YmdRange) bool {
+	return r.span().overlaps(o.span())
+}
+
+// Intersect 重なる区間を取得します
+func (r YmdRange) Intersect(o YmdRange) (YmdRange, bool) {
+	s, ok := r.span().intersect(o.span())
+	return ymdRangeOf(s), ok
+}
+
+// Union 重なるか隣接する区間を結合します。結合できない場合はokがfalseになります
+func (r YmdRange) Union(o YmdRange) (YmdRange, bool) {
+	s, ok := r.span().union(o.span())
+	return ymdRangeOf(s), ok
+}
+
+// Subtract 区間から指定した区間を取り除いた区間を取得します
+func (r YmdRange) Subtract(o YmdRange) []YmdRange {
+	return ymdRangesOf(r.span().subtract(o.span()))
+}
+
+// SplitMonths 月の区切りで区間を分割します
+func (r YmdRange) SplitMonths() []YmdRange {
+	return r.SplitTerm(101, 1)
+}
+
+// SplitTerm 期の区切りで区間を分割します
+//
+//	startは期首の月日、monthsは1期の月数です（年度: 401, 12、四半期: 401, 3）。
+//	期首の日が月末を超える場合は月末日を期首とします
+func (r YmdRange) SplitTerm(start Md, months int) []YmdRange {
+	if months < 1 {
+		return []YmdRange{r}
+	}
+	sm, sd := start.Part()
+	boundary := func(ymd Ymd) Ymd {
+		base := Ym((ymd.Year()-1)*100 + sm)
+		for i := 1; ; i++ {
+			if next := base.Add(0, months*i).Ymd(sd); next > ymd {
+				return next
+			}
+		}
+	}
+	return ymdRangesOf(splitSpan(r.span(), boundary))
+}
+
+// NormalizeYmdRanges 重なるか隣接する区間を結合して、開始日の昇順に並べます（空の区間は除外します）
+func NormalizeYmdRanges(v []YmdRange) []YmdRange {
+	x := make([]span[Ymd], len(v))
+	for i, r := range v {
+		x[i] = r.span()
+	}
+	return ymdRangesOf(normalizeSpans(x))
+}
+
+// Scan 区間を読み取ります
+func (r *YmdRange) Scan(i interface{}) (err error) {
+	if conv.IsEmpty(i) {
+		*r = YmdRange{}
+		return nil
+	}
+	var s string
+	switch v := i.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return errors.Wrapf(ErrUnkownType, "%T", i)
+	}
+	f, t, exclusive, ok := splitRangeText(s)
+	if !ok {
+		return errors.Wrapf(ErrValidate, "incorrect range : '%s'", s)
+	}
+	var x YmdRange
+	if x.From, err = ParseYmd(f); err != nil {
+		return
+	}
+	if x.To, err = ParseYmd(t); err != nil {
+		return
+	}
+	if exclusive {
+		x.To = x.To.Prev()
+	}
+	*r = x
+	return nil
+}
+
+// Value driver.Valuerインターフェイスの実装
+func (r YmdRange) Value() (driver.Value, error) {
+	if r.From == 0 && r.To == 0 {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+// UnmarshalJSON json.Unmarshalerインターフェイスの実装
+func (r *YmdRange) UnmarshalJSON(b []byte) (err error) {
+	if s := strings.TrimSpace(string(b)); s == "null" || s == `""` {
+		*r = YmdRange{}
+		return nil
+	} else if strings.HasPrefix(s, `"`) {
+		var x string
+		if err = errors.WithStack(json.Unmarshal(b, &x)); err != nil {
+			return
+		}
+		return r.Scan(x)
+	}
+	var x struct {
+		From Ymd `json:"from"`
+		To   Ymd `json:"to"`
+	}
+	if err = errors.WithStack(json.Unmarshal(b, &x)); err != nil {
+		return
+	}
+	*r = YmdRange(x)
+	return
+}
+
+// MarshalJSON json.Marshalerの実装
+func (r YmdRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		From int `json:"from"`
+		To   int `json:"to"`
+	}{int(r.From), int(r.To)})
+}
+
+// MarshalLogObject zapcore.ObjectMarshalerの実装（{"from":"20240101","to":"20240131"}）
+//
+//	LogValueと同じ文字列の形式で出力する場合はzapx.YmdRangeを使用します
+func (r YmdRange) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("from", r.From.String())
+	enc.AddString("to", r.To.String())
+	return nil
+}
@@ -0,0 +1,264 @@
+package types_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+type YmdRange = types.YmdRange
+
+func TestYmdRange(t *testing.T) {
+	r := types.NewYmdRange(20240331, 20240301)
+	if r.From != 20240301 || r.To != 20240331 {
+		t.Errorf("NewYmdRange: %v", r)
+	}
+	if act := r.Days(); act != 31 {
+		t.Errorf("Days: expect(31) != actual(%d)", act)
+	}
+	if act := (YmdRange{20231201, 20240229}).Days(); act != 91 {
+		t.Errorf("Days: expect(91) != actual(%d)", act)
+	}
+	if !r.Contains(20240301) || !r.Contains(20240331) || r.Contains(20240401) || r.Contains(0) {
+		t.Error("Contains error")
+	}
+	if !(YmdRange{}).IsEmpty() || !(YmdRange{20240302, 20240301}).IsEmpty() || r.IsEmpty() {
+		t.Error("IsEmpty error")
+	}
+	if v := r.Slice(); len(v) != 31 || v[0] != 20240301 || v[30] != 20240331 {
+		t.Errorf("Slice: %v", v)
+	}
+}
+
+func TestYmdRangeEach(t *testing.T) {
+	collect := func(fn func(func(Ymd) bool)) (v []Ymd) {
+		fn(func(ymd Ymd) bool {
+			v = append(v, ymd)
+			return true
+		})
+		return
+	}
+	r := YmdRange{From: 20240131, To: 20240430}
+	if act, exp := collect(r.EachMonth), []Ymd{20240131, 20240229, 20240331, 20240430}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("EachMonth: expect(%v) != actual(%v)", exp, act)
+	}
+	r = YmdRange{From: 20240301, To: 20240322}
+	if act, exp := collect(r.EachWeek), []Ymd{20240301, 20240308, 20240315, 20240322}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("EachWeek: expect(%v) != actual(%v)", exp, act)
+	}
+	n := 0
+	r.EachDay(func(Ymd) bool {
+		n++
+		return n < 3
+	})
+	if n != 3 {
+		t.Errorf("EachDay break: %d", n)
+	}
+}
+
+func TestYmdRangeSetOperation(t *testing.T) {
+	a := YmdRange{From: 20240301, To: 20240331}
+	b := YmdRange{From: 20240315, To: 20240415}
+	c := YmdRange{From: 20240401, To: 20240430}
+
+	if !a.Overlaps(b) || a.Overlaps(c) {
+		t.Error("Overlaps error")
+	}
+	if x, ok := a.Intersect(b); !ok || x != (YmdRange{20240315, 20240331}) {
+		t.Errorf("Intersect: %v, %v", x, ok)
+	}
+	if _, ok := a.Intersect(c); ok {
+		t.Error("Intersect must be false")
+	}
+	if x, ok := a.Union(c); !ok || x != (YmdRange{20240301, 20240430}) {
+		t.Errorf("Union(adjacent): %v, %v", x, ok)
+	}
+	if _, ok := a.Union(YmdRange{20240402, 20240430}); ok {
+		t.Error("Union must be false")
+	}
+	if act, exp := a.Subtract(YmdRange{20240310, 20240320}), []YmdRange{{20240301, 20240309}, {20240321, 20240331}}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("Subtract: expect(%v) != actual(%v)", exp, act)
+	}
+	if act, exp := a.Subtract(b), []YmdRange{{20240301, 20240314}}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("Subtract: expect(%v) != actual(%v)", exp, act)
+	}
+	if act := a.Subtract(YmdRange{20240201, 20240430}); len(act) != 0 {
+		t.Errorf("Subtract: %v", act)
+	}
+	if act, exp := a.Subtract(c), []YmdRange{a}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("Subtract: expect(%v) != actual(%v)", exp, act)
+	}
+
+	act := types.NormalizeYmdRanges([]YmdRange{c, {}, b, {20240601, 20240610}, a, {20240611, 20240612}})
+	exp := []YmdRange{{20240301, 20240430}, {20240601, 20240612}}
+	if !reflect.DeepEqual(act, exp) {
+		t.Errorf("Normalize: expect(%v) != actual(%v)", exp, act)
+	}
+}
+
+func TestYmdRangeSplit(t *testing.T) {
+	r := YmdRange{From: 20240115, To: 20240310}
+	if act, exp := r.SplitMonths(), []YmdRange{{20240115, 20240131}, {20240201, 20240229}, {20240301, 20240310}}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("SplitMonths: expect(%v) != actual(%v)", exp, act)
+	}
+	r = YmdRange{From: 20230101, To: 20250630}
+	if act, exp := r.SplitTerm(401, 12), []YmdRange{{20230101, 20230331}, {20230401, 20240331}, {20240401, 20250331}, {20250401, 20250630}}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("SplitTerm: expect(%v) != actual(%v)", exp, act)
+	}
+	r = YmdRange{From: 20240301, To: 20240531}
+	if act, exp := r.SplitTerm(121, 1), []YmdRange{{20240301, 20240320}, {20240321, 20240420}, {20240421, 20240520}, {20240521, 20240531}}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("SplitTerm(21日締め): expect(%v) != actual(%v)", exp, act)
+	}
+}
+
+func TestYmdRangeScan(t *testing.T) {
+	for _, x := range []struct {
+		v   interface{}
+		exp YmdRange
+		ok  bool
+	}{
+		{nil, YmdRange{}, true},
+		{"20240301-20240331", YmdRange{20240301, 20240331}, true},
+		{"2024/03/01～2024/03/31", YmdRange{20240301, 20240331}, true},
+		{"[2024-03-01,2024-04-01)", YmdRange{20240301, 20240331}, true},
+		{[]byte("[2024-03-01,2024-03-31]"), YmdRange{20240301, 20240331}, true},
+		{"20240301", YmdRange{}, false},
+		{"20240301-20240332", YmdRange{}, false},
+		{20240301, YmdRange{}, false},
+	} {
+		var r YmdRange
+		err := r.Scan(x.v)
+		if x.ok && err != nil {
+			t.Errorf("%v: %+v", x.v, err)
+		} else if !x.ok && err == nil {
+			t.Errorf("%v: must be error", x.v)
+		} else if r != x.exp {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.v, x.exp, r)
+		}
+	}
+
+	if v, err := (YmdRange{20240301, 20240331}).Value(); err != nil || v != "20240301-20240331" {
+		t.Errorf("Value: %v, %v", v, err)
+	}
+}
+
+func TestYmdRangeJSON(t *testing.T) {
+	r := YmdRange{From: 20240301, To: 20240331}
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Errorf("%+v", err)
+		return
+	}
+	if string(b) != `{"from":20240301,"to":20240331}` {
+		t.Errorf("marshal: %s", b)
+	}
+	for _, s := range []string{string(b), `"20240301-20240331"`, `{"from":"2024-03-01","to":"2024/03/31"}`} {
+		var x YmdRange
+		if err := json.Unmarshal([]byte(s), &x); err != nil {
+			t.Errorf("%s: %+v", s, err)
+		} else if x != r {
+			t.Errorf("%s: expect(%v) != actual(%v)", s, r, x)
+		}
+	}
+}
+
+func TestYmRange(t *testing.T) {
+	r := types.NewYmRange(202403, 202312)
+	if r.Months() != 4 {
+		t.Errorf("Months: %d", r.Months())
+	}
+	if r.Days() != 31+31+29+31 {
+		t.Errorf("Days: %d", r.Days())
+	}
+	if !r.ContainsYmd(20240229) || r.ContainsYmd(20240401) {
+		t.Error("ContainsYmd error")
+	}
+	if act, exp := r.Slice(), (types.YmSlice{202312, 202401, 202402, 202403}); !reflect.DeepEqual(act, exp) {
+		t.Errorf("Slice: expect(%v) != actual(%v)", exp, act)
+	}
+	if act, exp := r.Subtract(types.YmRange{From: 202401, To: 202401}), []types.YmRange{{From: 202312, To: 202312}, {From: 202402, To: 202403}}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("Subtract: expect(%v) != actual(%v)", exp, act)
+	}
+	if act, exp := (types.YmRange{From: 202301, To: 202412}).SplitTerm(4, 6), []types.YmRange{{From: 202301, To: 202303}, {From: 202304, To: 202309}, {From: 202310, To: 202403}, {From: 202404, To: 202409}, {From: 202410, To: 202412}}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("SplitTerm: expect(%v) != actual(%v)", exp, act)
+	}
+	if act, exp := types.NormalizeYmRanges([]types.YmRange{{From: 202404, To: 202406}, {From: 202401, To: 202403}}), []types.YmRange{{From: 202401, To: 202406}}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("Normalize: expect(%v) != actual(%v)", exp, act)
+	}
+	var x types.YmRange
+	if err := x.Scan("2024-01～2024-03"); err != nil || x != (types.YmRange{From: 202401, To: 202403}) {
+		t.Errorf("Scan: %v, %+v", x, err)
+	}
+}
+
+func TestYmdhmsRange(t *testing.T) {
+	r := types.YmdhmsRange{From: 20240301220000, To: 20240302015959}
+	if act := r.Seconds(); act != 4*3600 {
+		t.Errorf("Seconds: %d", act)
+	}
+	if act := r.Days(); act != 2 {
+		t.Errorf("Days: %d", act)
+	}
+	if act, exp := r.SplitDays(), []types.YmdhmsRange{{From: 20240301220000, To: 20240301235959}, {From: 20240302000000, To: 20240302015959}}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("SplitDays: expect(%v) != actual(%v)", exp, act)
+	}
+	if act, exp := (types.YmdhmsRange{From: 20240331120000, To: 20240401120000}).SplitMonths(), []types.YmdhmsRange{{From: 20240331120000, To: 20240331235959}, {From: 20240401000000, To: 20240401120000}}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("SplitMonths: expect(%v) != actual(%v)", exp, act)
+	}
+	if act, exp := r.Subtract(types.YmdhmsRange{From: 20240302000000, To: 20240302005959}), []types.YmdhmsRange{{From: 20240301220000, To: 20240301235959}, {From: 20240302010000, To: 20240302015959}}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("Subtract: expect(%v) != actual(%v)", exp, act)
+	}
+	if x, ok := r.Union(types.YmdhmsRange{From: 20240302020000, To: 20240302030000}); !ok || x != (types.YmdhmsRange{From: 20240301220000, To: 20240302030000}) {
+		t.Errorf("Union: %v, %v", x, ok)
+	}
+	var v []types.Ymdhms
+	r.Step(0, 0, 0, 1, 0, 0, func(yh types.Ymdhms) bool {
+		v = append(v, yh)
+		return true
+	})
+	if exp := []types.Ymdhms{20240301220000, 20240301230000, 20240302000000, 20240302010000}; !reflect.DeepEqual(v, exp) {
+		t.Errorf("Step: expect(%v) != actual(%v)", exp, v)
+	}
+	var x types.YmdhmsRange
+	if err := x.Scan("2024/03/01 22:00:00～2024/03/02 01:59:59"); err != nil || x != r {
+		t.Errorf("Scan: %v, %+v", x, err)
+	}
+	if b, err := json.Marshal(r); err != nil || string(b) != `{"from":"20240301220000","to":"20240302015959"}` {
+		t.Errorf("MarshalJSON: %s, %+v", b, err)
+	}
+}
+
+func TestYmdRangeStepInvalid(t *testing.T) {
+	r := types.YmdRange{From: 20240101, To: 20240110}
+	for _, x := range [][3]int{{0, 0, 0}, {0, 0, -1}, {0, -1, 0}, {-1, 0, 0}} {
+		n := 0
+		r.Step(x[0], x[1], x[2], func(types.Ymd) bool {
+			n++
+			return n < 100
+		})
+		if n != 0 {
+			t.Errorf("Step(%v): expect(%v) != actual(%v)", x, 0, n)
+		}
+	}
+
+	var v []types.Ymd
+	types.YmdRange{From: 20240229, To: 20280301}.Step(1, 0, 0, func(ymd types.Ymd) bool {
+		v = append(v, ymd)
+		return true
+	})
+	if exp := []types.Ymd{20240229, 20250228, 20260228, 20270228, 20280229}; !reflect.DeepEqual(v, exp) {
+		t.Errorf("Step yearly: expect(%v) != actual(%v)", exp, v)
+	}
+
+	yr := types.YmdhmsRange{From: 20240101000000, To: 20240102000000}
+	n := 0
+	yr.Step(0, 0, 0, -1, 0, 0, func(types.Ymdhms) bool {
+		n++
+		return n < 100
+	})
+	if n != 0 {
+		t.Errorf("YmdhmsRange.Step negative: expect(%v) != actual(%v)", 0, n)
+	}
+}
@@ -0,0 +1,241 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+
+	"github.com/MineTakaki/go-utils/conv"
+	"github.com/MineTakaki/go-utils/errors"
+	"go.uber.org/zap/zapcore"
+)
+
+// YmRange Ym型の閉区間（From, Toを含む）
+//
+//	From, Toのいずれかが0、またはFrom > Toの場合は空の区間として扱います
+type YmRange struct {
+	From Ym `json:"from"`
+	To   Ym `json:"to"`
+}
+
+// NewYmRange 開始年月と終了年月から区間を生成します。from > to の場合は入れ替えます
+func NewYmRange(from, to Ym) YmRange {
+	if from > to && to != 0 {
+		from, to = to, from
+	}
+	return YmRange{From: from, To: to}
+}
+
+func (r YmRange) span() span[Ym] {
+	return span[Ym]{r.From, r.To}
+}
+
+func ymRangeOf(s span[Ym]) YmRange {
+	return YmRange{From: s.from, To: s.to}
+}
+
+func ymRangesOf(v []span[Ym]) []YmRange {
+	x := make([]YmRange, len(v))
+	for i, s := range v {
+		x[i] = ymRangeOf(s)
+	}
+	return x
+}
+
+// IsEmpty 空の区間か判定します
+func (r YmRange) IsEmpty() bool {
+	return r.span().empty()
+}
+
+// String string型変換
+func (r YmRange) String() string {
+	if r.From == 0 && r.To == 0 {
+		return ""
+	}
+	return r.From.String() + "-" + r.To.String()
+}
+
+// Contains 年月が区間に含まれるか判定します
+func (r YmRange) Contains(ym Ym) bool {
+	return r.span().contains(ym)
+}
+
+// ContainsYmd 日付が区間に含まれるか判定します
+func (r YmRange) ContainsYmd(ymd Ymd) bool {
+	return r.span().contains(ymd.YearMonth())
+}
+
+// Months 区間の月数を取得します
+func (r YmRange) Months() int {
+	if r.IsEmpty() {
+		return 0
+	}
+	fy, fm := r.From.Part()
+	ty, tm := r.To.Part()
+	return (ty-fy)*12 + tm - fm + 1
+}
+
+// Days 区間の日数を取得します
+func (r YmRange) Days() int {
+	return r.YmdRange().Days()
+}
+
+// YmdRange 月初から月末までのYmdRange型に変換します
+func (r YmRange) YmdRange() YmdRange {
+	if r.IsEmpty() {
+		return YmdRange{}
+	}
+	return YmdRange{From: r.From.First(), To: r.To.Last()}
+}
+
+// EachMonth 区間内の年月を1か月ずつ処理します。fnがfalseを返した場合は中断します
+func (r YmRange) EachMonth(fn func(Ym) bool) {
+	if r.IsEmpty() {
+		return
+	}
+	for ym := r.From; ym <= r.To; ym = ym.Next() {
+		if !fn(ym) {
+			return
+		}
+	}
+}
+
+// Slice 区間内の年月をスライスで取得します
+func (r YmRange) Slice() YmSlice {
+	v := make(YmSlice, 0, r.Months())
+	r.EachMonth(func(ym Ym) bool {
+		v = append(v, ym)
+		return true
+	})
+	return v
+}
+
+// Overlaps 区間が重なるか判定します
+func (r YmRange) Overlaps(o YmRange) bool {
+	return r.span().overlaps(o.span())
+}
+
+// Intersect 重なる区間を取得します
+func (r YmRange) Intersect(o YmRange) (YmRange, bool) {
+	s, ok := r.span().intersect(o.span())
+	return ymRangeOf(s), ok
+}
+
+// Union 重なるか隣接する区間を結合します。結合できない場合はokがfalseになります
+func (r YmRange) Union(o YmRange) (YmRange, bool) {
+	s, ok := r.span().union(o.span())
+	return ymRangeOf(s), ok
+}
+
+// Subtract 区間から指定した区間を取り除いた区間を取得します
+func (r YmRange) Subtract(o YmRange) []YmRange {
+	return ymRangesOf(r.span().subtract(o.span()))
+}
+
+// SplitTerm 期の区切りで区間を分割します
+//
+//	startは期首の月、monthsは1期の月数です（年度: 4, 12、半期: 4, 6）
+func (r YmRange) SplitTerm(start, months int) []YmRange {
+	if months < 1 {
+		return []YmRange{r}
+	}
+	boundary := func(ym Ym) Ym {
+		base := Ym((ym.Year()-1)*100 + start)
+		for i := 1; ; i++ {
+			if next := base.Add(0, months*i); next > ym {
+				return next
+			}
+		}
+	}
+	return ymRangesOf(splitSpan(r.span(), boundary))
+}
+
+// NormalizeYmRanges 重なるか隣接する区間を結合して、開始年月の昇順に並べます（空の区間は除外します）
+func NormalizeYmRanges(v []YmRange) []YmRange {
+	x := make([]span[Ym], len(v))
+	for i, r := range v {
+		x[i] = r.span()
+	}
+	return ymRangesOf(normalizeSpans(x))
+}
+
+// Scan 区間を読み取ります
+func (r *YmRange) Scan(i interface{}) (err error) {
+	if conv.IsEmpty(i) {
+		*r = YmRange{}
+		return nil
+	}
+	var s string
+	switch v := i.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return errors.Wrapf(ErrUnkownType, "%T", i)
+	}
+	f, t, exclusive, ok := splitRangeText(s)
+	if !ok {
+		return errors.Wrapf(ErrValidate, "incorrect range : '%s'", s)
+	}
+	var x YmRange
+	if x.From, err = ParseYm(f); err != nil {
+		return
+	}
+	if x.To, err = ParseYm(t); err != nil {
+		return
+	}
+	if exclusive {
+		x.To = x.To.Prev()
+	}
+	*r = x
+	return nil
+}
+
+// Value driver.Valuerインターフェイスの実装
+func (r YmRange) Value() (driver.Value, error) {
+	if r.From == 0 && r.To == 0 {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+// UnmarshalJSON json.Unmarshalerインターフェイスの実装
+func (r *YmRange) UnmarshalJSON(b []byte) (err error) {
+	if s := strings.TrimSpace(string(b)); s == "null" || s == `""` {
+		*r = YmRange{}
+		return nil
+	} else if strings.HasPrefix(s, `"`) {
+		var x string
+		if err = errors.WithStack(json.Unmarshal(b, &x)); err != nil {
+			return
+		}
+		return r.Scan(x)
+	}
+	var x struct {
+		From Ym `json:"from"`
+		To   Ym `json:"to"`
+	}
+	if err = errors.WithStack(json.Unmarshal(b, &x)); err != nil {
+		return
+	}
+	*r = YmRange(x)
+	return
+}
+
+// MarshalJSON json.Marshalerの実装
+func (r YmRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		From int `json:"from"`
+		To   int `json:"to"`
+	}{int(r.From), int(r.To)})
+}
+
+// MarshalLogObject zapcore.ObjectMarshalerの実装（{"from":"202401","to":"202403"}）
+//
+//	LogValueと同じ文字列の形式で出力する場合はzapx.YmRangeを使用します
+func (r YmRange) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("from", r.From.String())
+	enc.AddString("to", r.To.String())
+	return nil
+}
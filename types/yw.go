@@ -0,0 +1,375 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MineTakaki/go-utils/conv"
+	"github.com/MineTakaki/go-utils/errors"
+	"go.uber.org/zap/zapcore"
+)
+
+type (
+	//Yw yyyyww形式でISO-8601の年と週番号を表す整数型
+	//
+	//	週は月曜日に始まり、その週の木曜日が属する年を週の年とします（1/1が金～日曜日の場合は前年の最終週になります）
+	Yw int
+
+	//YwSlice Yw型のスライス
+	YwSlice = Slice[Yw]
+)
+
+// ISOWeek ISO-8601の年と週番号を取得します
+func (ymd Ymd) ISOWeek() (y, w int) {
+	if ymd == 0 {
+		return
+	}
+	// 同じ週の木曜日の年が週の年になります
+	thu := ymd.Add(0, 0, 3-isoWeekdayIndex(ymd))
+	y = thu.Year()
+	w = (thu.Days()-Ymd(y*10000+101).Days())/7 + 1
+	return
+}
+
+// Yw ISO-8601の年週をYw型で取得します
+func (ymd Ymd) Yw() Yw {
+	if ymd == 0 {
+		return 0
+	}
+	y, w := ymd.ISOWeek()
+	return Yw(y*100 + w)
+}
+
+// isoWeekdayIndex 月曜日を0とする曜日の番号を取得します
+func isoWeekdayIndex(ymd Ymd) int {
+	// グレゴリウス暦1年1月1日は月曜日です
+	return ymd.Days() % 7
+}
+
+// WeeksInYear ISO-8601の年の週数（52または53）を取得します
+func WeeksInYear(y int) int {
+	_, w := Ymd(y*10000 + 1228).ISOWeek()
+	return w
+}
+
+// ValidateYw 年週が有効か確認します（既定の妥当性確認の条件を使用します）
+func ValidateYw(y, w int) (ok bool, err error) {
+	return DefaultValidationPolicy().ValidateYw(y, w)
+}
+
+// ToYw 年週からYw型に変換します
+func ToYw(y, w int) (yw Yw, err error) {
+	if _, err = ValidateYw(y, w); err != nil {
+		return
+	}
+	return Yw(y*100 + w), nil
+}
+
+// ParseYw Yw型に変換します
+func ParseYw(i interface{}) (yw Yw, err error) {
+	return ParseYwWith(nil, i)
+}
+
+// ParseYw2 Yw型に変換します
+func ParseYw2(i interface{}, err *error) (yw Yw) {
+	var e error
+	yw, e = ParseYw(i)
+	if err != nil {
+		*err = e
+	}
+	return
+}
+
+// YwNow 現在の年週（既定のタイムゾーン）を取得します
+func YwNow() Yw {
+	return YmdNow().Yw()
+}
+
+// String string型変換
+func (yw Yw) String() string {
+	if yw == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%06d", yw)
+}
+
+// FormatYw 年週の形式でstring型に整形して変換します（FormatYw("-W", false) → 2024-W05）
+func (yw Yw) FormatYw(sep string, zeroSuppress bool) string {
+	if yw == 0 {
+		return ""
+	}
+	y, w := yw.Part()
+	sb := strings.Builder{}
+	sb.Grow(len(sep) + 6)
+	if zeroSuppress {
+		sb.WriteString(strconv.Itoa(y))
+		sb.WriteString(sep)
+		sb.WriteString(strconv.Itoa(w))
+		return sb.String()
+	}
+	sb.WriteString(fillZero4(y))
+	sb.WriteString(sep)
+	sb.WriteString(fillZero2(w))
+	return sb.String()
+}
+
+// ISOString ISO-8601の形式（2024-W05）でstring型に変換します
+func (yw Yw) ISOString() string {
+	return yw.FormatYw("-W", false)
+}
+
+// CsvFormat CSV出力用のstring型変換
+func (yw Yw) CsvFormat() string {
+	if yw == 0 {
+		return ""
+	}
+	return strconv.Itoa(int(yw))
+}
+
+// Validate 年週が正しいか確認します
+func (yw Yw) Validate() (bool, error) {
+	return yw.ValidateWith(nil)
+}
+
+// Year 年を取得します
+func (yw Yw) Year() int {
+	return int(yw) / 100
+}
+
+// Week 週番号を取得します
+func (yw Yw) Week() int {
+	return int(yw) % 100
+}
+
+// Part 年週の要素を取得します
+func (yw Yw) Part() (y, w int) {
+	y = int(yw) / 100
+	w = int(yw) % 100
+	return
+}
+
+// Parts 年週の要素を配列で取得します
+func (yw Yw) Parts() []int {
+	v := make([]int, 2)
+	v[0], v[1] = yw.Part()
+	return v
+}
+
+// First 週の初日（月曜日）を取得します
+func (yw Yw) First() Ymd {
+	if yw == 0 {
+		return 0
+	}
+	y, w := yw.Part()
+	jan4 := Ymd(y*10000 + 104)
+	return jan4.Add(0, 0, (w-1)*7-isoWeekdayIndex(jan4))
+}
+
+// Last 週の最終日（日曜日）を取得します
+func (yw Yw) Last() Ymd {
+	if yw == 0 {
+		return 0
+	}
+	return yw.First().Add(0, 0, 6)
+}
+
+// Term From～To
+func (yw Yw) Term() (fm, to Ymd) {
+	return yw.First(), yw.Last()
+}
+
+// Range 週の期間を取得します
+func (yw Yw) Range() YmdRange {
+	return YmdRange{From: yw.First(), To: yw.Last()}
+}
+
+// Ymd 曜日を指定して週内の日付を取得します
+func (yw Yw) Ymd(wd time.Weekday) Ymd {
+	if yw == 0 {
+		return 0
+	}
+	return yw.First().Add(0, 0, (int(wd)+6)%7)
+}
+
+// Add 週を加算します（減算はマイナス値を引数にセットします）
+func (yw Yw) Add(dw int) Yw {
+	if yw == 0 {
+		return 0
+	}
+	return yw.First().Add(0, 0, dw*7).Yw()
+}
+
+// Prev 一週前の値を取得します
+func (yw Yw) Prev() Yw {
+	return yw.Add(-1)
+}
+
+// Next 一週後の値を取得します
+func (yw Yw) Next() Yw {
+	return yw.Add(1)
+}
+
+// WeeksUntil ywからoまでの週数（o - yw）を取得します。いずれかが0の場合は0を返します
+func (yw Yw) WeeksUntil(o Yw) int {
+	if yw == 0 || o == 0 {
+		return 0
+	}
+	return o.First().DiffDays(yw.First()) / 7
+}
+
+// Between 二つの年週の間に入るか判定します
+func (yw Yw) Between(f, t Yw) bool {
+	if yw == 0 || f == 0 || t == 0 {
+		return false
+	}
+	return f <= yw && yw <= t
+}
+
+// Min 指定した年週と比較して小さい値を返します
+func (yw Yw) Min(o Yw) Yw {
+	if yw == 0 {
+		return o
+	}
+	if o != 0 && yw > o {
+		return o
+	}
+	return yw
+}
+
+// Max 指定した年週と比較して大きい値を返します
+func (yw Yw) Max(o Yw) Yw {
+	if yw == 0 {
+		return o
+	}
+	if o != 0 && yw < o {
+		return o
+	}
+	return yw
+}
+
+// Compare Yw同志を比較します
+func (yw Yw) Compare(o Yw) int {
+	if yw < o {
+		return -1
+	}
+	if yw > o {
+		return 1
+	}
+	return 0
+}
+
+// Scan 年週を読み取ります
+//
+//	202405, "202405", "2024-W05", "2024W05", "2024-W05-3"（曜日付き）のほか、日付（Ymd, time.Time, "2024年3月5日" 等の文字列）はその日の属する週として読み取ります。
+//	整数値は型によらず桁数で判断し、8桁の場合は年月日（20240105, Ymd(20240105)）、それ以外は年週（202405）として扱います
+func (yw *Yw) Scan(i interface{}) (err error) {
+	if conv.IsEmpty(i) {
+		*yw = 0
+		return nil
+	}
+	switch v := i.(type) {
+	case DateParts:
+		*yw = v.Ymd().Yw()
+		return nil
+	case time.Time:
+		*yw = YmdFromGoTimeIn(v, v.Location()).Yw()
+		return nil
+	case *time.Time:
+		*yw = YmdFromGoTimeIn(*v, v.Location()).Yw()
+		return nil
+	}
+	if n, ok := conv.Int(i); ok {
+		if n >= 10000000 {
+			*yw = Ymd(n).Yw()
+		} else {
+			*yw = Yw(n)
+		}
+		return
+	}
+	if s, ok := i.(string); ok {
+		if x, ok := parseISOWeek(s); ok {
+			*yw = x
+			return nil
+		}
+		var x Ymd
+		if x, err = scanLenient(s, lenientYmd, DateParts.Ymd); err == nil {
+			*yw = x.Yw()
+		}
+		return
+	}
+	return errors.WithStack(ErrValidate)
+}
+
+// parseISOWeek "2024-W05", "2024W05", "2024-W05-3", "2024W053" を読み取ります
+func parseISOWeek(s string) (Yw, bool) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	i := strings.IndexByte(s, 'W')
+	if i < 4 {
+		return 0, false
+	}
+	y, err := strconv.Atoi(strings.TrimSuffix(s[:i], "-"))
+	if err != nil {
+		return 0, false
+	}
+	s = s[i+1:]
+	if len(s) < 2 {
+		return 0, false
+	}
+	w, err := strconv.Atoi(s[:2])
+	if err != nil {
+		return 0, false
+	}
+	if s = strings.TrimPrefix(s[2:], "-"); s != "" {
+		if len(s) != 1 || s[0] < '1' || s[0] > '7' {
+			return 0, false
+		}
+	}
+	return Yw(y*100 + w), true
+}
+
+// Value driver.Valuerインターフェイスの実装
+func (yw Yw) Value() (driver.Value, error) {
+	if yw == 0 {
+		return nil, nil
+	}
+	return int64(yw), nil
+}
+
+// UnmarshalJSON json.Unmarshalerインターフェイスの実装
+func (yw *Yw) UnmarshalJSON(b []byte) (err error) {
+	var s interface{}
+	if err = errors.WithStack(json.Unmarshal(b, &s)); err != nil {
+		return
+	}
+	var x Yw
+	if x, err = ParseYw(s); err != nil {
+		return
+	}
+	*yw = x
+	return
+}
+
+// MarshalJSON json.Marshalerの実装（表現はSetJSONFormatで変更できます）
+func (yw Yw) MarshalJSON() ([]byte, error) {
+	return marshalJSON(yw, JSONNumber, yw.ISOString)
+}
+
+// MarshalLogObject zapcore.ObjectMarshalerの実装（{"yw":"202405"}）
+//
+//	LogValueと同じ文字列の形式で出力する場合はzapx.Ywを使用します
+func (yw Yw) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("yw", yw.String())
+	return nil
+}
+
+// SplitWeeks ISO-8601の週（月曜日始まり）の区切りで区間を分割します
+func (r YmdRange) SplitWeeks() []YmdRange {
+	boundary := func(ymd Ymd) Ymd {
+		return ymd.Add(0, 0, 7-isoWeekdayIndex(ymd))
+	}
+	return ymdRangesOf(splitSpan(r.span(), boundary))
+}
@@ -0,0 +1,115 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/MineTakaki/go-utils/types"
+)
+
+func TestYmdISOWeek(t *testing.T) {
+	for _, x := range []struct {
+		ymd Ymd
+		exp types.Yw
+	}{
+		{20210103, 202053},
+		{20210104, 202101},
+		{20241230, 202501},
+		{20240101, 202401},
+		{20261231, 202653},
+		{20270101, 202653},
+		{20270104, 202701},
+	} {
+		if act := x.ymd.Yw(); act != x.exp {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.ymd, x.exp, act)
+		}
+	}
+	if act := types.WeeksInYear(2020); act != 53 {
+		t.Errorf("expect(%v) != actual(%v)", 53, act)
+	}
+	if act := types.WeeksInYear(2024); act != 52 {
+		t.Errorf("expect(%v) != actual(%v)", 52, act)
+	}
+}
+
+func TestYw(t *testing.T) {
+	yw := types.Yw(202501)
+	if act, exp := yw.Range(), (YmdRange{From: 20241230, To: 20250105}); act != exp {
+		t.Errorf("expect(%v) != actual(%v)", exp, act)
+	}
+	if act := yw.Ymd(time.Wednesday); act != 20250101 {
+		t.Errorf("expect(%v) != actual(%v)", 20250101, act)
+	}
+	for _, x := range []struct {
+		yw  types.Yw
+		dw  int
+		exp types.Yw
+	}{
+		{202052, 1, 202053},
+		{202053, 1, 202101},
+		{202101, -1, 202053},
+		{202401, 52, 202501},
+	} {
+		if act := x.yw.Add(x.dw); act != x.exp {
+			t.Errorf("%v+%d: expect(%v) != actual(%v)", x.yw, x.dw, x.exp, act)
+		}
+	}
+	if act := types.Yw(202401).WeeksUntil(202501); act != 52 {
+		t.Errorf("expect(%v) != actual(%v)", 52, act)
+	}
+	if act := types.Yw(202405).ISOString(); act != "2024-W05" {
+		t.Errorf("expect(%v) != actual(%v)", "2024-W05", act)
+	}
+}
+
+func TestYwScan(t *testing.T) {
+	for _, x := range []struct {
+		v   interface{}
+		exp types.Yw
+	}{
+		{"2024-W05", 202405},
+		{"2024w05", 202405},
+		{"2024-W05-3", 202405},
+		{202405, 202405},
+		{Ymd(20241230), 202501},
+		{20241230, 202501},
+		{int64(20240105), 202401},
+		{Ymd(20240105), 202401},
+		{"20240105", 202401},
+		{time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC), 202053},
+		{nil, 0},
+	} {
+		act, err := types.ParseYw(x.v)
+		if err != nil {
+			t.Errorf("%v: %+v", x.v, err)
+		} else if act != x.exp {
+			t.Errorf("%v: expect(%v) != actual(%v)", x.v, x.exp, act)
+		}
+	}
+	for _, v := range []interface{}{"2024-W53", 202400, "2024-W05-8", "W05"} {
+		if _, err := types.ParseYw(v); err == nil {
+			t.Errorf("%v: error expected", v)
+		}
+	}
+
+	var act struct {
+		W types.Yw `json:"w"`
+	}
+	if err := json.Unmarshal([]byte(`{"w":"2020-W53"}`), &act); err != nil || act.W != 202053 {
+		t.Errorf("expect(%v) != actual(%v) %v", 202053, act.W, err)
+	}
+}
+
+func TestYmdRangeSplitWeeks(t *testing.T) {
+	act := YmdRange{From: 20240103, To: 20240117}.SplitWeeks()
+	exp := []YmdRange{{From: 20240103, To: 20240107}, {From: 20240108, To: 20240114}, {From: 20240115, To: 20240117}}
+	if len(act) != len(exp) {
+		t.Fatalf("expect(%v) != actual(%v)", exp, act)
+	}
+	for i := range exp {
+		if act[i] != exp[i] {
+			t.Errorf("expect(%v) != actual(%v)", exp[i], act[i])
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package zapx
+
+import (
+	"github.com/MineTakaki/go-utils/types"
+	"github.com/MineTakaki/go-utils/types/decimal"
+	"github.com/MineTakaki/go-utils/types/money"
+	"go.uber.org/zap"
+)
+
+// Ymd Ymd型の値を文字列として出力するフィールドを生成します
+func Ymd(key string, v types.Ymd) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// Ym Ym型の値を文字列として出力するフィールドを生成します
+func Ym(key string, v types.Ym) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// Md Md型の値を文字列として出力するフィールドを生成します
+func Md(key string, v types.Md) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// Hms Hms型の値を文字列として出力するフィールドを生成します
+func Hms(key string, v types.Hms) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// Ymdhms Ymdhms型の値を文字列として出力するフィールドを生成します
+func Ymdhms(key string, v types.Ymdhms) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// YmdhmsMs YmdhmsMs型の値を文字列として出力するフィールドを生成します
+func YmdhmsMs(key string, v types.YmdhmsMs) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// Yw Yw型の値を文字列として出力するフィールドを生成します
+func Yw(key string, v types.Yw) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// nullable NULLの場合はnilを出力するフィールドを生成します
+func nullable(key, s string, valid bool) zap.Field {
+	if !valid {
+		return zap.Reflect(key, nil)
+	}
+	return zap.String(key, s)
+}
+
+// NullYmd NullYmd型の値を出力するフィールドを生成します（NULLの場合はnil）
+func NullYmd(key string, v types.NullYmd) zap.Field {
+	return nullable(key, v.Ymd.String(), v.Valid)
+}
+
+// NullYm NullYm型の値を出力するフィールドを生成します（NULLの場合はnil）
+func NullYm(key string, v types.NullYm) zap.Field {
+	return nullable(key, v.Ym.String(), v.Valid)
+}
+
+// NullHms NullHms型の値を出力するフィールドを生成します（NULLの場合はnil）
+func NullHms(key string, v types.NullHms) zap.Field {
+	return nullable(key, v.Hms.String(), v.Valid)
+}
+
+// NullYmdhms NullYmdhms型の値を出力するフィールドを生成します（NULLの場合はnil）
+func NullYmdhms(key string, v types.NullYmdhms) zap.Field {
+	return nullable(key, v.Ymdhms.String(), v.Valid)
+}
+
+// YmdRange YmdRange型の値を文字列として出力するフィールドを生成します
+func YmdRange(key string, v types.YmdRange) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// YmRange YmRange型の値を文字列として出力するフィールドを生成します
+func YmRange(key string, v types.YmRange) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// YmdhmsRange YmdhmsRange型の値を文字列として出力するフィールドを生成します
+func YmdhmsRange(key string, v types.YmdhmsRange) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// HmsRange HmsRange型の値を文字列として出力するフィールドを生成します
+func HmsRange(key string, v types.HmsRange) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// Decimal Decimal型の値を文字列として出力するフィールドを生成します
+func Decimal(key string, v decimal.Decimal) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// NullDecimal NullDecimal型の値を出力するフィールドを生成します（NULLの場合はnil）
+func NullDecimal(key string, v decimal.NullDecimal) zap.Field {
+	return nullable(key, v.Decimal.String(), v.Valid)
+}
+
+// Money Money型の値を金額と通貨のオブジェクトとして出力するフィールドを生成します（{"amount":"12.30","currency":"USD"}）
+func Money(key string, v money.Money) zap.Field {
+	return zap.Object(key, v)
+}
@@ -0,0 +1,48 @@
+package zapx_test
+
+import (
+	"testing"
+
+	"github.com/MineTakaki/go-utils/types"
+	"github.com/MineTakaki/go-utils/types/decimal"
+	"github.com/MineTakaki/go-utils/types/money"
+	"github.com/MineTakaki/go-utils/zapx"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestFields(t *testing.T) {
+	for _, x := range []struct {
+		f   zap.Field
+		exp interface{}
+	}{
+		{zapx.Ymd("k", 20240305), "20240305"},
+		{zapx.Ymdhms("k", 20240305103015), "20240305103015"},
+		{zapx.Hms("k", 93000), "093000"},
+		{zapx.NullYmd("k", types.NullYmd{Ymd: 20240305, Valid: true}), "20240305"},
+		{zapx.NullYmd("k", types.NullYmd{}), nil},
+		{zapx.YmdRange("k", types.YmdRange{From: 20240101, To: 20240131}), "20240101-20240131"},
+		{zapx.YmRange("k", types.YmRange{From: 202401, To: 202403}), "202401-202403"},
+		{zapx.YmdhmsRange("k", types.YmdhmsRange{From: 20240101090000, To: 20240101180000}), "20240101090000-20240101180000"},
+		{zapx.Decimal("k", decimal.RequireFromString("12.50")), "12.5"},
+		{zapx.NullDecimal("k", decimal.Null), nil},
+	} {
+		enc := zapcore.NewMapObjectEncoder()
+		x.f.AddTo(enc)
+		if act := enc.Fields["k"]; act != x.exp {
+			t.Errorf("expect(%v) != actual(%v)", x.exp, act)
+		}
+	}
+}
+
+func TestMoney(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+	zapx.Money("k", money.Must(decimal.RequireFromString("12.3"), money.USD)).AddTo(enc)
+	m, ok := enc.Fields["k"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("unexpected field: %v", enc.Fields["k"])
+	}
+	if m["amount"] != "12.30" || m["currency"] != "USD" {
+		t.Errorf("expect(%v) != actual(%v)", `{"amount":"12.30","currency":"USD"}`, m)
+	}
+}